@@ -319,3 +319,21 @@ func (e ErrorCode) Error() string {
 	return fmt.Sprintf("unknown error code: %d", e)
 }
 
+// APIError is an error returned by the contract API. It carries the server
+// message alongside the code and unwraps to the ErrorCode, so
+// errors.Is(err, ErrBalanceInsufficient) matches it.
+type APIError struct {
+	Code    ErrorCode
+	Message string
+}
+
+func (e *APIError) Error() string {
+	if e.Message == "" {
+		return e.Code.Error()
+	}
+	return fmt.Sprintf("%s (code %d): %s", e.Code.Error(), int(e.Code), e.Message)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Code
+}
@@ -0,0 +1,432 @@
+// Package orderbook maintains a local copy of a futures order book from the
+// depth stream and REST depth snapshots.
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/rest"
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsmarket"
+	"github.com/IvanTurko/mexc-sdk-go/internal/book"
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrVersionGap is reported through the resync callback when an update
+	// skips a version.
+	ErrVersionGap = errors.New("order book version gap")
+
+	// ErrInvalidUpdate is reported through the resync callback when the
+	// stream delivers an update that cannot be decoded.
+	ErrInvalidUpdate = book.ErrInvalidUpdate
+
+	// ErrAlreadyStarted is returned when Start is called more than once.
+	ErrAlreadyStarted = book.ErrAlreadyStarted
+
+	// ErrContractNotFound is returned by Start when the contract size cannot
+	// be loaded because the contract is unknown.
+	ErrContractNotFound = errors.New("contract not found")
+
+	// ErrClosed is returned by Start when Close is called before Start
+	// completes.
+	ErrClosed = book.ErrClosed
+)
+
+// Subscriber is the part of wsmarket.WSMarket used by OrderBook.
+type Subscriber interface {
+	Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error)
+}
+
+// Level is a single price level of the book. Quantity is in contracts.
+type Level struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// Options configures OrderBook.
+type Options = func(*OrderBook)
+
+// OrderBook is a local futures order book kept in sync with the exchange.
+//
+// The book subscribes to wsmarket.NewBookDepthSub, buffers increments while
+// it loads a snapshot from rest.OrderBookService and then applies every
+// increment whose Version follows the version it holds. Levels with a zero
+// quantity are removed. A skipped version triggers a new snapshot
+// automatically. All methods are safe for concurrent use.
+type OrderBook struct {
+	symbol         string
+	market         Subscriber
+	snapshot       *rest.OrderBookService
+	contractDetail *rest.ContractDetailService
+	contractSize   decimal.Decimal
+	retryDelay     time.Duration
+
+	onUpdate func(*OrderBook)
+	onResync func(reason error)
+	onError  func(error)
+
+	feed   *feed
+	syncer *book.Syncer[*wsmarket.DepthSnapshot, *rest.OrderBookDepths]
+}
+
+// NewOrderBook creates an OrderBook for the contract symbol that streams
+// increments through market. Call Start to begin syncing.
+//
+// Panics:
+//   - symbol is empty
+//   - market is nil
+func NewOrderBook(symbol string, market Subscriber, opts ...Options) *OrderBook {
+	if symbol == "" {
+		panic("NewOrderBook: invalid symbol name")
+	}
+	if market == nil {
+		panic("NewOrderBook: market must not be nil")
+	}
+
+	b := &OrderBook{
+		symbol:         symbol,
+		market:         market,
+		snapshot:       rest.NewOrderBookService(),
+		contractDetail: rest.NewContractDetailService(),
+		retryDelay:     1 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	b.snapshot.Symbol(symbol)
+	b.contractDetail.Symbol(symbol)
+	b.feed = &feed{
+		symbol:         symbol,
+		market:         market,
+		snapshot:       b.snapshot,
+		contractDetail: b.contractDetail,
+		contractSize:   b.contractSize,
+	}
+	b.syncer = book.NewSyncer(b.feed, book.Config{
+		RetryDelay: b.retryDelay,
+		OnUpdate:   b.notifyUpdate,
+		OnResync:   b.onResync,
+		OnError:    b.onError,
+	})
+	return b
+}
+
+// WithSnapshotService sets the service used to load depth snapshots.
+// Its symbol is overwritten with the symbol of the book.
+func WithSnapshotService(s *rest.OrderBookService) Options {
+	return func(b *OrderBook) {
+		b.snapshot = s
+	}
+}
+
+// WithContractDetailService sets the service used by Start to look up the
+// contract size. Its symbol is overwritten with the symbol of the book.
+func WithContractDetailService(s *rest.ContractDetailService) Options {
+	return func(b *OrderBook) {
+		b.contractDetail = s
+	}
+}
+
+// WithContractSize sets the amount of coin one contract represents, so Start
+// does not have to look it up.
+func WithContractSize(size decimal.Decimal) Options {
+	return func(b *OrderBook) {
+		b.contractSize = size
+	}
+}
+
+// WithRetryDelay sets how long to wait before loading another snapshot when
+// the previous attempt failed or was older than the buffered increments.
+func WithRetryDelay(d time.Duration) Options {
+	return func(b *OrderBook) {
+		b.retryDelay = d
+	}
+}
+
+// WithOnUpdate registers a callback invoked after the book changes.
+// The callback runs on the goroutine that applied the change and may read
+// the book.
+func WithOnUpdate(f func(*OrderBook)) Options {
+	return func(b *OrderBook) {
+		b.onUpdate = f
+	}
+}
+
+// WithOnResync registers a callback invoked when the book drops out of sync
+// and starts loading a new snapshot. reason wraps ErrVersionGap or
+// ErrInvalidUpdate.
+func WithOnResync(f func(reason error)) Options {
+	return func(b *OrderBook) {
+		b.onResync = f
+	}
+}
+
+// WithOnError registers a callback for snapshot requests that fail.
+// The book keeps retrying after the retry delay.
+func WithOnError(f func(error)) Options {
+	return func(b *OrderBook) {
+		b.onError = f
+	}
+}
+
+// Start looks up the contract size unless it was configured, subscribes to
+// the depth stream and loads the first snapshot in the background. The book
+// becomes Ready once the snapshot is applied.
+func (b *OrderBook) Start(ctx context.Context) error {
+	return b.syncer.Start(ctx)
+}
+
+// Close unsubscribes from the stream and stops any pending snapshot load.
+// Safe to call multiple times.
+func (b *OrderBook) Close(ctx context.Context) error {
+	return b.syncer.Close(ctx)
+}
+
+// Symbol returns the contract of the book.
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// ContractSize returns the amount of coin one contract represents.
+func (b *OrderBook) ContractSize() decimal.Decimal {
+	return b.feed.size()
+}
+
+// Ready reports whether the book is in sync with the exchange.
+func (b *OrderBook) Ready() bool {
+	return b.syncer.Ready()
+}
+
+// Version returns the version of the last applied increment.
+func (b *OrderBook) Version() int64 {
+	return b.feed.version.Load()
+}
+
+// BestBid returns the highest bid. ok is false when the side is empty or the
+// book is not Ready.
+func (b *OrderBook) BestBid() (level Level, ok bool) {
+	return b.best(b.syncer.Bids())
+}
+
+// BestAsk returns the lowest ask. ok is false when the side is empty or the
+// book is not Ready.
+func (b *OrderBook) BestAsk() (level Level, ok bool) {
+	return b.best(b.syncer.Asks())
+}
+
+// Bids returns the best n bids, highest first. n <= 0 returns every level.
+// Returns nil while the book is not Ready.
+func (b *OrderBook) Bids(n int) []Level {
+	return b.top(b.syncer.Bids(), n)
+}
+
+// Asks returns the best n asks, lowest first. n <= 0 returns every level.
+// Returns nil while the book is not Ready.
+func (b *OrderBook) Asks(n int) []Level {
+	return b.top(b.syncer.Asks(), n)
+}
+
+// BidQuantityAt returns the bid quantity in contracts resting at exactly price.
+func (b *OrderBook) BidQuantityAt(price decimal.Decimal) decimal.Decimal {
+	return b.quantityAt(b.syncer.Bids(), price)
+}
+
+// AskQuantityAt returns the ask quantity in contracts resting at exactly price.
+func (b *OrderBook) AskQuantityAt(price decimal.Decimal) decimal.Decimal {
+	return b.quantityAt(b.syncer.Asks(), price)
+}
+
+// BidDepthTo returns the number of contracts bid at or above price.
+func (b *OrderBook) BidDepthTo(price decimal.Decimal) decimal.Decimal {
+	depth, _ := b.depthTo(b.syncer.Bids(), price)
+	return depth
+}
+
+// AskDepthTo returns the number of contracts offered at or below price.
+func (b *OrderBook) AskDepthTo(price decimal.Decimal) decimal.Decimal {
+	depth, _ := b.depthTo(b.syncer.Asks(), price)
+	return depth
+}
+
+// BidCoinDepthTo returns the amount of coin bid at or above price.
+func (b *OrderBook) BidCoinDepthTo(price decimal.Decimal) decimal.Decimal {
+	return b.ContractsToCoin(b.BidDepthTo(price))
+}
+
+// AskCoinDepthTo returns the amount of coin offered at or below price.
+func (b *OrderBook) AskCoinDepthTo(price decimal.Decimal) decimal.Decimal {
+	return b.ContractsToCoin(b.AskDepthTo(price))
+}
+
+// BidNotionalTo returns the quote value of all bids at or above price.
+func (b *OrderBook) BidNotionalTo(price decimal.Decimal) decimal.Decimal {
+	_, notional := b.depthTo(b.syncer.Bids(), price)
+	return notional
+}
+
+// AskNotionalTo returns the quote value of all asks at or below price.
+func (b *OrderBook) AskNotionalTo(price decimal.Decimal) decimal.Decimal {
+	_, notional := b.depthTo(b.syncer.Asks(), price)
+	return notional
+}
+
+// ContractsToCoin converts a number of contracts into coin.
+func (b *OrderBook) ContractsToCoin(contracts decimal.Decimal) decimal.Decimal {
+	return contracts.Mul(b.ContractSize())
+}
+
+// CoinToContracts converts an amount of coin into contracts, rounded down to
+// a whole contract. Returns zero if the contract size is unknown.
+func (b *OrderBook) CoinToContracts(coin decimal.Decimal) decimal.Decimal {
+	size := b.ContractSize()
+	if size.IsZero() {
+		return decimal.Zero
+	}
+	return coin.Div(size).Floor()
+}
+
+func (b *OrderBook) best(side *book.Side) (level Level, ok bool) {
+	b.syncer.View(func() {
+		var l book.Level
+		l, ok = side.Best()
+		level = Level(l)
+	})
+	return level, ok
+}
+
+func (b *OrderBook) top(side *book.Side, n int) []Level {
+	var out []Level
+	b.syncer.View(func() {
+		levels := side.Top(n)
+		out = make([]Level, len(levels))
+		for i, l := range levels {
+			out[i] = Level(l)
+		}
+	})
+	return out
+}
+
+func (b *OrderBook) quantityAt(side *book.Side, price decimal.Decimal) decimal.Decimal {
+	quantity := decimal.Zero
+	b.syncer.View(func() {
+		quantity = side.QuantityAt(price)
+	})
+	return quantity
+}
+
+// depthTo returns the contracts and quote notional of all levels priced at
+// or better than price.
+func (b *OrderBook) depthTo(side *book.Side, price decimal.Decimal) (contracts, notional decimal.Decimal) {
+	contracts, notional = decimal.Zero, decimal.Zero
+	b.syncer.View(func() {
+		side.EachTo(price, func(l book.Level) {
+			contracts = contracts.Add(l.Quantity)
+			notional = notional.Add(l.Price.Mul(l.Quantity))
+		})
+	})
+	return contracts, notional.Mul(b.ContractSize())
+}
+
+func (b *OrderBook) notifyUpdate() {
+	if b.onUpdate != nil {
+		b.onUpdate(b)
+	}
+}
+
+// feed streams depth increments and sequences them by version. Load and
+// Apply run with the syncer locked.
+type feed struct {
+	symbol         string
+	market         Subscriber
+	snapshot       *rest.OrderBookService
+	contractDetail *rest.ContractDetailService
+
+	mu           sync.RWMutex
+	contractSize decimal.Decimal
+
+	version atomic.Int64
+}
+
+func (f *feed) size() decimal.Decimal {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.contractSize
+}
+
+// Subscribe looks up the contract size unless it is known and subscribes to
+// the depth stream.
+func (f *feed) Subscribe(ctx context.Context, onUpdate func(*wsmarket.DepthSnapshot), onInvalid func(error)) (book.Handle, error) {
+	if f.size().IsZero() {
+		size, err := f.loadContractSize(ctx)
+		if err != nil {
+			return nil, err
+		}
+		f.mu.Lock()
+		f.contractSize = size
+		f.mu.Unlock()
+	}
+
+	sub := wsmarket.NewBookDepthSub(f.symbol, onUpdate).
+		SetOnInvalid(onInvalid)
+
+	handle, err := f.market.Subscribe(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return handle, nil
+}
+
+func (f *feed) loadContractSize(ctx context.Context) (decimal.Decimal, error) {
+	contracts, err := f.contractDetail.Do(ctx)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	for _, c := range contracts {
+		if c.Symbol == f.symbol {
+			return c.ContractSize, nil
+		}
+	}
+	return decimal.Zero, fmt.Errorf("%w: %s", ErrContractNotFound, f.symbol)
+}
+
+func (f *feed) Snapshot(ctx context.Context) (*rest.OrderBookDepths, error) {
+	return f.snapshot.Do(ctx)
+}
+
+func (f *feed) Load(snap *rest.OrderBookDepths, bids, asks *book.Side) {
+	for _, l := range snap.Bids {
+		bids.Set(l.Price, l.Quantity)
+	}
+	for _, l := range snap.Asks {
+		asks.Set(l.Price, l.Quantity)
+	}
+	f.version.Store(snap.Version)
+}
+
+// Apply applies d if it is the next version. Increments already covered by
+// the book are skipped.
+func (f *feed) Apply(d *wsmarket.DepthSnapshot, bids, asks *book.Side) (bool, error) {
+	version := f.version.Load()
+	if d.Version <= version {
+		return false, nil
+	}
+	if d.Version != version+1 {
+		return false, fmt.Errorf("%w: have %d, got %d", ErrVersionGap, version, d.Version)
+	}
+
+	for _, l := range d.Bids {
+		bids.Set(l.Price, l.Quantity)
+	}
+	for _, l := range d.Asks {
+		asks.Set(l.Price, l.Quantity)
+	}
+	f.version.Store(d.Version)
+	return true, nil
+}
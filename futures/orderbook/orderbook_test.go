@@ -0,0 +1,288 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/rest"
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsmarket"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHandle struct {
+	calls int
+}
+
+func (h *fakeHandle) Unsubscribe(ctx context.Context) error {
+	h.calls++
+	return nil
+}
+
+type fakeSubscriber struct {
+	handle *fakeHandle
+	subs   []wsmarket.Subscription
+}
+
+func (f *fakeSubscriber) Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error) {
+	f.subs = append(f.subs, sub)
+	return f.handle, nil
+}
+
+// restClient serves contract detail requests with detail and depth requests
+// with the given snapshots in order, repeating the last one.
+func restClient(detail string, snapshots ...string) (*testutil.FakeHTTPClient, *int) {
+	var mu sync.Mutex
+	calls := 0
+	client := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			if strings.Contains(req.FullURL, "/api/v1/contract/detail") {
+				return &transport.Response{StatusCode: 200, Body: []byte(detail)}, nil
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			body := snapshots[min(calls, len(snapshots)-1)]
+			calls++
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+	return client, &calls
+}
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func depth(version int64, bids, asks [][2]string) *wsmarket.DepthSnapshot {
+	toLevels := func(in [][2]string) []wsmarket.DepthLevel {
+		var out []wsmarket.DepthLevel
+		for _, l := range in {
+			out = append(out, wsmarket.DepthLevel{Price: d(l[0]), Quantity: d(l[1])})
+		}
+		return out
+	}
+	return &wsmarket.DepthSnapshot{
+		Symbol:  "BTC_USDT",
+		Bids:    toLevels(bids),
+		Asks:    toLevels(asks),
+		Version: version,
+	}
+}
+
+func newTestBook(t *testing.T, client transport.HTTPClient, opts ...Options) (*OrderBook, *fakeSubscriber) {
+	t.Helper()
+	sub := &fakeSubscriber{handle: &fakeHandle{}}
+	opts = append([]Options{
+		WithSnapshotService(rest.NewOrderBookService().WithClient(client)),
+		WithContractDetailService(rest.NewContractDetailService().WithClient(client)),
+		WithRetryDelay(time.Millisecond),
+	}, opts...)
+	return NewOrderBook("BTC_USDT", sub, opts...), sub
+}
+
+const (
+	detailBTC   = `{"success":true,"code":0,"data":{"symbol":"BTC_USDT","contractSize":0.0001}}`
+	snapshot100 = `{"success":true,"code":0,"data":{"version":100,"timestamp":1,
+		"bids":[[99,10,1],[98,20,2]],"asks":[[101,10,1],[102,30,3]]}}`
+)
+
+func TestNewOrderBook_Panics(t *testing.T) {
+	assert.Panics(t, func() { NewOrderBook("", &fakeSubscriber{}) })
+	assert.Panics(t, func() { NewOrderBook("BTC_USDT", nil) })
+}
+
+func TestOrderBook_InitialSyncReplaysBuffer(t *testing.T) {
+	client, _ := restClient(detailBTC, snapshot100)
+	b, sub := newTestBook(t, client)
+
+	b.syncer.HandleUpdate(depth(99, [][2]string{{"50", "1"}}, nil))
+	b.syncer.HandleUpdate(depth(101, [][2]string{{"99", "15"}}, nil))
+	b.syncer.HandleUpdate(depth(102, nil, [][2]string{{"101", "0"}}))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Len(t, sub.subs, 1)
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	assert.Equal(t, int64(102), b.Version())
+	assert.True(t, b.ContractSize().Equal(d("0.0001")))
+	assert.True(t, b.BidQuantityAt(d("99")).Equal(d("15")))
+	assert.True(t, b.BidQuantityAt(d("50")).IsZero())
+
+	ask, ok := b.BestAsk()
+	require.True(t, ok)
+	assert.True(t, ask.Price.Equal(d("102")))
+}
+
+func TestOrderBook_GapTriggersResync(t *testing.T) {
+	client, calls := restClient(detailBTC,
+		snapshot100,
+		`{"success":true,"code":0,"data":{"version":110,"bids":[[97,4,1]],"asks":[[103,1,1]]}}`,
+	)
+
+	resyncs := make(chan error, 1)
+	b, _ := newTestBook(t, client, WithOnResync(func(reason error) { resyncs <- reason }))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	b.syncer.HandleUpdate(depth(101, [][2]string{{"99", "0"}}, nil))
+	assert.Equal(t, int64(101), b.Version())
+	assert.True(t, b.BidQuantityAt(d("99")).IsZero())
+
+	b.syncer.HandleUpdate(depth(111, [][2]string{{"96", "1"}}, nil))
+
+	select {
+	case reason := <-resyncs:
+		assert.True(t, errors.Is(reason, ErrVersionGap))
+	case <-time.After(time.Second):
+		t.Fatal("resync callback not called")
+	}
+
+	require.Eventually(t, func() bool { return b.Ready() && b.Version() == 111 }, time.Second, time.Millisecond)
+	assert.Equal(t, 2, *calls)
+
+	bid, ok := b.BestBid()
+	require.True(t, ok)
+	assert.True(t, bid.Price.Equal(d("97")))
+	assert.True(t, b.BidQuantityAt(d("96")).Equal(d("1")))
+}
+
+func TestOrderBook_StaleSnapshotIsRetried(t *testing.T) {
+	client, calls := restClient(detailBTC,
+		`{"success":true,"code":0,"data":{"version":50,"bids":[],"asks":[]}}`,
+		snapshot100,
+	)
+	b, _ := newTestBook(t, client)
+
+	b.syncer.HandleUpdate(depth(101, nil, [][2]string{{"104", "1"}}))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	assert.Equal(t, 2, *calls)
+	assert.True(t, b.AskQuantityAt(d("104")).Equal(d("1")))
+}
+
+func TestOrderBook_ContractHelpers(t *testing.T) {
+	client, _ := restClient(detailBTC, snapshot100)
+	b, _ := newTestBook(t, client)
+
+	assert.True(t, b.AskNotionalTo(d("102")).IsZero())
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	assert.True(t, b.AskDepthTo(d("101.5")).Equal(d("10")))
+	assert.True(t, b.AskDepthTo(d("102")).Equal(d("40")))
+	assert.True(t, b.AskCoinDepthTo(d("102")).Equal(d("0.004")))
+	// 101*10*0.0001 + 102*30*0.0001
+	assert.True(t, b.AskNotionalTo(d("102")).Equal(d("0.407")))
+
+	assert.True(t, b.BidDepthTo(d("98")).Equal(d("30")))
+	assert.True(t, b.BidCoinDepthTo(d("99")).Equal(d("0.001")))
+	// 99*10*0.0001 + 98*20*0.0001
+	assert.True(t, b.BidNotionalTo(d("98")).Equal(d("0.295")))
+
+	assert.True(t, b.ContractsToCoin(d("25")).Equal(d("0.0025")))
+	assert.True(t, b.CoinToContracts(d("0.00255")).Equal(d("25")))
+}
+
+func TestOrderBook_WithContractSizeSkipsLookup(t *testing.T) {
+	client, _ := restClient(`{"success":false,"code":1001}`, snapshot100)
+	b, _ := newTestBook(t, client, WithContractSize(d("0.01")))
+
+	require.NoError(t, b.Start(context.Background()))
+	assert.True(t, b.ContractSize().Equal(d("0.01")))
+	require.NoError(t, b.Close(context.Background()))
+}
+
+func TestOrderBook_StartContractLookupFails(t *testing.T) {
+	client, _ := restClient(`{"success":true,"code":0,"data":{"symbol":"ETH_USDT","contractSize":0.01}}`, snapshot100)
+	b, sub := newTestBook(t, client)
+
+	err := b.Start(context.Background())
+	assert.ErrorIs(t, err, ErrContractNotFound)
+	assert.Empty(t, sub.subs)
+	assert.False(t, b.Ready())
+}
+
+func TestOrderBook_StartAndClose(t *testing.T) {
+	client, _ := restClient(detailBTC, snapshot100)
+	b, sub := newTestBook(t, client)
+
+	require.NoError(t, b.Start(context.Background()))
+	assert.ErrorIs(t, b.Start(context.Background()), ErrAlreadyStarted)
+
+	require.NoError(t, b.Close(context.Background()))
+	require.NoError(t, b.Close(context.Background()))
+	assert.Equal(t, 1, sub.handle.calls)
+	assert.False(t, b.Ready())
+}
+
+// blockingSubscriber holds Subscribe until release is closed.
+type blockingSubscriber struct {
+	fakeSubscriber
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingSubscriber) Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error) {
+	close(f.entered)
+	<-f.release
+	return f.fakeSubscriber.Subscribe(ctx, sub)
+}
+
+func TestOrderBook_CloseDuringContractLookup(t *testing.T) {
+	entered := make(chan struct{})
+	release := make(chan struct{})
+	client := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			close(entered)
+			<-release
+			return &transport.Response{StatusCode: 200, Body: []byte(detailBTC)}, nil
+		},
+	}
+	b, sub := newTestBook(t, client)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- b.Start(context.Background()) }()
+
+	<-entered
+	require.NoError(t, b.Close(context.Background()))
+	close(release)
+
+	assert.ErrorIs(t, <-errCh, ErrClosed)
+	assert.Equal(t, 1, sub.handle.calls)
+	assert.False(t, b.Ready())
+}
+
+func TestOrderBook_CloseDuringSubscribe(t *testing.T) {
+	client, _ := restClient(detailBTC, snapshot100)
+	sub := &blockingSubscriber{
+		fakeSubscriber: fakeSubscriber{handle: &fakeHandle{}},
+		entered:        make(chan struct{}),
+		release:        make(chan struct{}),
+	}
+	b := NewOrderBook("BTC_USDT", sub,
+		WithSnapshotService(rest.NewOrderBookService().WithClient(client)),
+		WithContractDetailService(rest.NewContractDetailService().WithClient(client)),
+	)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- b.Start(context.Background()) }()
+
+	<-sub.entered
+	require.NoError(t, b.Close(context.Background()))
+	close(sub.release)
+
+	assert.ErrorIs(t, <-errCh, ErrClosed)
+	assert.Equal(t, 1, sub.handle.calls)
+	assert.False(t, b.Ready())
+}
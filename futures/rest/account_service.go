@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// AccountAsset represents the balance of a single currency in the futures account.
+type AccountAsset struct {
+	Currency         string          `json:"currency"`
+	PositionMargin   decimal.Decimal `json:"positionMargin"`
+	FrozenBalance    decimal.Decimal `json:"frozenBalance"`
+	AvailableBalance decimal.Decimal `json:"availableBalance"`
+	CashBalance      decimal.Decimal `json:"cashBalance"`
+	Equity           decimal.Decimal `json:"equity"`
+	Unrealized       decimal.Decimal `json:"unrealized"`
+	Bonus            decimal.Decimal `json:"bonus"`
+	AvailableCash    decimal.Decimal `json:"availableCash"`
+	AvailableOpen    decimal.Decimal `json:"availableOpen"`
+}
+
+// AccountAssetsService gets all balances of the futures account.
+type AccountAssetsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewAccountAssetsService creates a new AccountAssetsService.
+func NewAccountAssetsService(apiKey, secretKey string) *AccountAssetsService {
+	return &AccountAssetsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AccountAssetsService) WithClient(client transport.HTTPClient) *AccountAssetsService {
+	s.client = client
+	return s
+}
+
+// Do executes the service.
+func (s *AccountAssetsService) Do(ctx context.Context) ([]AccountAsset, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/account/assets").
+		Build()
+
+	op := "AccountAssetsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	assets, err := decodeResponse[[]AccountAsset](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *assets, nil
+}
+
+// AccountAssetService gets the balance of a single currency in the futures account.
+type AccountAssetService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	currency   string
+}
+
+// NewAccountAssetService creates a new AccountAssetService.
+func NewAccountAssetService(apiKey, secretKey string) *AccountAssetService {
+	return &AccountAssetService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AccountAssetService) WithClient(client transport.HTTPClient) *AccountAssetService {
+	s.client = client
+	return s
+}
+
+// Currency sets the currency of the asset, e.g. "USDT".
+func (s *AccountAssetService) Currency(currency string) *AccountAssetService {
+	s.currency = currency
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *AccountAssetService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("AccountAssetService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *AccountAssetService) Do(ctx context.Context) (*AccountAsset, error) {
+	path := fmt.Sprintf("/api/v1/private/account/asset/%s", s.currency)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "AccountAssetService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[AccountAsset](resp.Body, op)
+}
+
+func (s *AccountAssetService) validate() error {
+	if s.currency == "" {
+		return errors.New("currency is required")
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const accountAssetJSON = `{
+	"currency": "USDT",
+	"positionMargin": 120.5,
+	"frozenBalance": 10,
+	"availableBalance": 869.5,
+	"cashBalance": 1000,
+	"equity": 1012.3,
+	"unrealized": 12.3,
+	"bonus": 0,
+	"availableCash": 869.5,
+	"availableOpen": 869.5
+}`
+
+func TestAccountAssetsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/account/assets")
+			assert.Equal(t, "test-key", req.Headers.Get("ApiKey"))
+			assert.NotEmpty(t, req.Headers.Get("Signature"))
+			assert.NotEmpty(t, req.Headers.Get("Request-Time"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + accountAssetJSON + `]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewAccountAssetsService("test-key", "test-secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	asset := result[0]
+	assert.Equal(t, "USDT", asset.Currency)
+	testutil.AssertDecimalEqual(t, asset.PositionMargin, "120.5", "position margin mismatch")
+	testutil.AssertDecimalEqual(t, asset.Equity, "1012.3", "equity mismatch")
+	testutil.AssertDecimalEqual(t, asset.AvailableOpen, "869.5", "available open mismatch")
+}
+
+func TestAccountAssetService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewAccountAssetService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "AccountAssetService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "currency is required")
+}
+
+func TestAccountAssetService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Contains(t, req.FullURL, "/api/v1/private/account/asset/USDT")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":` + accountAssetJSON + `}`),
+			}, nil
+		},
+	}
+
+	result, err := NewAccountAssetService("key", "secret").WithClient(fakeClient).Currency("USDT").Do(context.Background())
+	require.NoError(t, err)
+	testutil.AssertDecimalEqual(t, result.CashBalance, "1000", "cash balance mismatch")
+}
@@ -0,0 +1,245 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+const maxBatchOrders = 50
+
+// BatchOrder describes a single order within a batch.
+type BatchOrder struct {
+	order orderRequest
+}
+
+// NewBatchOrder creates a new BatchOrder.
+func NewBatchOrder() *BatchOrder {
+	return &BatchOrder{}
+}
+
+// Symbol sets the contract of the order.
+func (b *BatchOrder) Symbol(symbol string) *BatchOrder {
+	b.order.symbol = symbol
+	return b
+}
+
+// Price sets the price of the order.
+func (b *BatchOrder) Price(price decimal.Decimal) *BatchOrder {
+	b.order.price = &price
+	return b
+}
+
+// Vol sets the volume of the order in contracts.
+func (b *BatchOrder) Vol(vol decimal.Decimal) *BatchOrder {
+	b.order.vol = &vol
+	return b
+}
+
+// Leverage sets the leverage of the order. Required when opening an isolated position.
+func (b *BatchOrder) Leverage(leverage int) *BatchOrder {
+	b.order.leverage = &leverage
+	return b
+}
+
+// Side sets the side of the order.
+func (b *BatchOrder) Side(side wsuser.OrderSide) *BatchOrder {
+	b.order.side = side
+	return b
+}
+
+// Type sets the type of the order.
+func (b *BatchOrder) Type(orderType wsuser.OrderType) *BatchOrder {
+	b.order.orderType = orderType
+	return b
+}
+
+// OpenType sets the margin mode of the order.
+func (b *BatchOrder) OpenType(openType wsuser.OpenType) *BatchOrder {
+	b.order.openType = openType
+	return b
+}
+
+// PositionID sets the position to close.
+func (b *BatchOrder) PositionID(id int64) *BatchOrder {
+	b.order.positionID = &id
+	return b
+}
+
+// ExternalOid sets the client-assigned order ID.
+func (b *BatchOrder) ExternalOid(oid string) *BatchOrder {
+	b.order.externalOid = &oid
+	return b
+}
+
+// StopLossPrice attaches a stop-loss price to the order.
+func (b *BatchOrder) StopLossPrice(price decimal.Decimal) *BatchOrder {
+	b.order.stopLossPrice = &price
+	return b
+}
+
+// TakeProfitPrice attaches a take-profit price to the order.
+func (b *BatchOrder) TakeProfitPrice(price decimal.Decimal) *BatchOrder {
+	b.order.takeProfitPrice = &price
+	return b
+}
+
+// ReduceOnly sets whether the order may only reduce a position.
+func (b *BatchOrder) ReduceOnly(reduceOnly bool) *BatchOrder {
+	b.order.reduceOnly = &reduceOnly
+	return b
+}
+
+// BatchOrderResult represents the outcome of a single order within a batch.
+// Exactly one of OrderID and Err is set.
+type BatchOrderResult struct {
+	// Index is the position of the order in the submitted batch.
+	Index       int
+	OrderID     string
+	ExternalOid string
+	Err         error
+}
+
+type batchOrderItem struct {
+	OrderID     json.Number `json:"orderId"`
+	ExternalOid string      `json:"externalOid"`
+	ErrorCode   int         `json:"errorCode"`
+	ErrorMsg    string      `json:"errorMsg"`
+}
+
+// SubmitBatchOrderService places up to 50 futures orders in a single request.
+type SubmitBatchOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	orders     []*BatchOrder
+}
+
+// NewSubmitBatchOrderService creates a new SubmitBatchOrderService.
+func NewSubmitBatchOrderService(apiKey, secretKey string) *SubmitBatchOrderService {
+	return &SubmitBatchOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *SubmitBatchOrderService) WithClient(client transport.HTTPClient) *SubmitBatchOrderService {
+	s.client = client
+	return s
+}
+
+// Orders sets the orders of the batch.
+func (s *SubmitBatchOrderService) Orders(orders ...*BatchOrder) *SubmitBatchOrderService {
+	s.orders = orders
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *SubmitBatchOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("SubmitBatchOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service. A failure of an individual order is reported in
+// its BatchOrderResult and does not produce an error.
+func (s *SubmitBatchOrderService) Do(ctx context.Context) ([]BatchOrderResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/order/submit_batch").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "SubmitBatchOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	items, err := decodeResponse[[]batchOrderItem](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]BatchOrderResult, len(*items))
+	for i, item := range *items {
+		results[i] = BatchOrderResult{
+			Index:       i,
+			ExternalOid: item.ExternalOid,
+		}
+		if item.ErrorCode != 0 {
+			results[i].Err = errs.ErrorCode(item.ErrorCode)
+			continue
+		}
+		results[i].OrderID = item.OrderID.String()
+	}
+	return results, nil
+}
+
+func (s *SubmitBatchOrderService) validate() error {
+	var errs []string
+
+	if len(s.orders) == 0 {
+		errs = append(errs, "at least one order is required")
+	}
+
+	if len(s.orders) > maxBatchOrders {
+		errs = append(errs, fmt.Sprintf("no more than %d orders are allowed", maxBatchOrders))
+	}
+
+	for i, o := range s.orders {
+		if o == nil {
+			errs = append(errs, fmt.Sprintf("order %d: order is nil", i))
+			continue
+		}
+		for _, e := range o.order.validate() {
+			errs = append(errs, fmt.Sprintf("order %d: %s", i, e))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *SubmitBatchOrderService) buildBody() []byte {
+	params := make([]orderParams, 0, len(s.orders))
+	for _, o := range s.orders {
+		if o == nil {
+			continue
+		}
+		params = append(params, o.order.params())
+	}
+
+	// Marshalling cannot fail: orderParams holds only plain values.
+	body, _ := json.Marshal(params)
+	return body
+}
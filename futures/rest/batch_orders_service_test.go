@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBatchOrder() *BatchOrder {
+	return NewBatchOrder().
+		Symbol("BTC_USDT").
+		Price(decimal.NewFromInt(30000)).
+		Vol(decimal.NewFromInt(1)).
+		Side(wsuser.OrderSideOpenLong).
+		Type(wsuser.OrderTypeLimit).
+		OpenType(wsuser.OpenTypeCross)
+}
+
+func TestSubmitBatchOrderService_validate(t *testing.T) {
+	t.Run("valid batch", func(t *testing.T) {
+		err := NewSubmitBatchOrderService("key", "secret").
+			Orders(newTestBatchOrder(), newTestBatchOrder().Symbol("ETH_USDT")).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		err := NewSubmitBatchOrderService("key", "secret").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one order is required")
+	})
+
+	t.Run("too many orders", func(t *testing.T) {
+		orders := make([]*BatchOrder, maxBatchOrders+1)
+		for i := range orders {
+			orders[i] = newTestBatchOrder()
+		}
+		err := NewSubmitBatchOrderService("key", "secret").Orders(orders...).validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "no more than 50 orders are allowed")
+	})
+
+	t.Run("invalid orders are indexed", func(t *testing.T) {
+		err := NewSubmitBatchOrderService("key", "secret").
+			Orders(newTestBatchOrder(), nil, newTestBatchOrder().OpenType(wsuser.OpenTypeIsolated)).
+			validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "order 1: order is nil")
+		assert.Contains(t, err.Error(), "order 2: leverage is required when opening an isolated position")
+	})
+}
+
+func TestSubmitBatchOrderService_buildBody(t *testing.T) {
+	body := NewSubmitBatchOrderService("key", "secret").
+		Orders(newTestBatchOrder().ExternalOid("a"), newTestBatchOrder().Side(wsuser.OrderSideCloseLong).PositionID(7)).
+		buildBody()
+
+	assert.JSONEq(t, `[
+		{"symbol":"BTC_USDT","price":30000,"vol":1,"side":1,"type":1,"openType":2,"externalOid":"a"},
+		{"symbol":"BTC_USDT","price":30000,"vol":1,"side":4,"type":1,"openType":2,"positionId":7}
+	]`, string(body))
+}
+
+func TestSubmitBatchOrderService_Do_PartialFailure(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/submit_batch")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[
+					{"orderId":739113577038255616,"externalOid":"a","errorCode":0,"errorMsg":"success"},
+					{"externalOid":"b","errorCode":2005,"errorMsg":"balance insufficient"}
+				]}`),
+			}, nil
+		},
+	}
+
+	results, err := NewSubmitBatchOrderService("key", "secret").
+		WithClient(fakeClient).
+		Orders(newTestBatchOrder().ExternalOid("a"), newTestBatchOrder().ExternalOid("b")).
+		Do(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, 0, results[0].Index)
+	assert.Equal(t, "739113577038255616", results[0].OrderID)
+	assert.NoError(t, results[0].Err)
+
+	assert.Equal(t, 1, results[1].Index)
+	assert.Equal(t, "b", results[1].ExternalOid)
+	assert.Empty(t, results[1].OrderID)
+	assert.True(t, errors.Is(results[1].Err, errs.ErrBalanceInsufficient))
+}
@@ -0,0 +1,311 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+const maxCancelOrders = 50
+
+// CancelOrderResult represents the outcome of cancelling a single order.
+type CancelOrderResult struct {
+	OrderID string
+	// Err is set when the order could not be cancelled.
+	Err error
+}
+
+type cancelOrderItem struct {
+	OrderID   json.Number `json:"orderId"`
+	ErrorCode int         `json:"errorCode"`
+	ErrorMsg  string      `json:"errorMsg"`
+}
+
+// CancelOrderService cancels up to 50 orders by their IDs.
+type CancelOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	orderIDs   []string
+}
+
+// NewCancelOrderService creates a new CancelOrderService.
+func NewCancelOrderService(apiKey, secretKey string) *CancelOrderService {
+	return &CancelOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelOrderService) WithClient(client transport.HTTPClient) *CancelOrderService {
+	s.client = client
+	return s
+}
+
+// OrderIDs sets the IDs of the orders to cancel.
+func (s *CancelOrderService) OrderIDs(ids ...string) *CancelOrderService {
+	s.orderIDs = ids
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service. A failure to cancel an individual order is
+// reported in its CancelOrderResult and does not produce an error.
+func (s *CancelOrderService) Do(ctx context.Context) ([]CancelOrderResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/order/cancel").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	items, err := decodeResponse[[]cancelOrderItem](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]CancelOrderResult, len(*items))
+	for i, item := range *items {
+		results[i].OrderID = item.OrderID.String()
+		if item.ErrorCode != 0 {
+			results[i].Err = errs.ErrorCode(item.ErrorCode)
+		}
+	}
+	return results, nil
+}
+
+func (s *CancelOrderService) validate() error {
+	var errs []string
+
+	if len(s.orderIDs) == 0 {
+		errs = append(errs, "at least one orderId is required")
+	}
+
+	if len(s.orderIDs) > maxCancelOrders {
+		errs = append(errs, fmt.Sprintf("no more than %d orders are allowed", maxCancelOrders))
+	}
+
+	for i, id := range s.orderIDs {
+		if _, err := strconv.ParseInt(id, 10, 64); err != nil {
+			errs = append(errs, fmt.Sprintf("orderId %d: must be numeric", i))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelOrderService) buildBody() []byte {
+	ids := make([]json.Number, len(s.orderIDs))
+	for i, id := range s.orderIDs {
+		ids[i] = json.Number(id)
+	}
+
+	// Marshalling fails only for non-numeric IDs, which validate rejects.
+	body, _ := json.Marshal(ids)
+	return body
+}
+
+// CancelOrderByExternalOidService cancels an order by its client-assigned ID.
+type CancelOrderByExternalOidService struct {
+	client      transport.HTTPClient
+	reqBuilder  *requestBuilder
+	symbol      string
+	externalOid string
+}
+
+// NewCancelOrderByExternalOidService creates a new CancelOrderByExternalOidService.
+func NewCancelOrderByExternalOidService(apiKey, secretKey string) *CancelOrderByExternalOidService {
+	return &CancelOrderByExternalOidService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelOrderByExternalOidService) WithClient(client transport.HTTPClient) *CancelOrderByExternalOidService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract of the order.
+func (s *CancelOrderByExternalOidService) Symbol(symbol string) *CancelOrderByExternalOidService {
+	s.symbol = symbol
+	return s
+}
+
+// ExternalOid sets the client-assigned ID of the order.
+func (s *CancelOrderByExternalOidService) ExternalOid(oid string) *CancelOrderByExternalOidService {
+	s.externalOid = oid
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelOrderByExternalOidService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelOrderByExternalOidService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelOrderByExternalOidService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/order/cancel_with_external").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelOrderByExternalOidService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelOrderByExternalOidService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.externalOid == "" {
+		errs = append(errs, "externalOid is required")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelOrderByExternalOidService) buildBody() []byte {
+	body, _ := json.Marshal(map[string]string{
+		"symbol":      s.symbol,
+		"externalOid": s.externalOid,
+	})
+	return body
+}
+
+// CancelAllOrdersService cancels all open orders, optionally limited to one contract.
+type CancelAllOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewCancelAllOrdersService creates a new CancelAllOrdersService.
+func NewCancelAllOrdersService(apiKey, secretKey string) *CancelAllOrdersService {
+	return &CancelAllOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelAllOrdersService) WithClient(client transport.HTTPClient) *CancelAllOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the cancellation to a single contract.
+func (s *CancelAllOrdersService) Symbol(symbol string) *CancelAllOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *CancelAllOrdersService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/order/cancel_all").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelAllOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelAllOrdersService) buildBody() []byte {
+	params := make(map[string]string)
+	if s.symbol != nil {
+		params["symbol"] = *s.symbol
+	}
+	body, _ := json.Marshal(params)
+	return body
+}
@@ -0,0 +1,129 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOrderService_validate(t *testing.T) {
+	assert.NoError(t, NewCancelOrderService("key", "secret").OrderIDs("1", "2").validate())
+
+	err := NewCancelOrderService("key", "secret").validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one orderId is required")
+
+	err = NewCancelOrderService("key", "secret").OrderIDs("1", "abc").validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "orderId 1: must be numeric")
+}
+
+func TestCancelOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/cancel")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `[101716841474621953,108885377505140736]`, string(body))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[
+					{"orderId":101716841474621953,"errorCode":0,"errorMsg":"success"},
+					{"orderId":108885377505140736,"errorCode":2040,"errorMsg":"order not exist"}
+				]}`),
+			}, nil
+		},
+	}
+
+	results, err := NewCancelOrderService("key", "secret").
+		WithClient(fakeClient).
+		OrderIDs("101716841474621953", "108885377505140736").
+		Do(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	assert.Equal(t, "101716841474621953", results[0].OrderID)
+	assert.NoError(t, results[0].Err)
+	assert.Equal(t, "108885377505140736", results[1].OrderID)
+
+	var code errs.ErrorCode
+	assert.True(t, errors.As(results[1].Err, &code))
+	assert.Equal(t, errs.ErrorCode(2040), code)
+}
+
+func TestCancelOrderByExternalOidService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewCancelOrderByExternalOidService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CancelOrderByExternalOidService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+	assert.Contains(t, sdkErr.Message(), "externalOid is required")
+}
+
+func TestCancelOrderByExternalOidService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/cancel_with_external")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"symbol":"BTC_USDT","externalOid":"my-order"}`, string(body))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewCancelOrderByExternalOidService("key", "secret").
+		WithClient(fakeClient).
+		Symbol("BTC_USDT").
+		ExternalOid("my-order").
+		Do(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestCancelAllOrdersService_Do(t *testing.T) {
+	t.Run("all contracts", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				assert.Contains(t, req.FullURL, "/api/v1/private/order/cancel_all")
+
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{}`, string(body))
+
+				return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+			},
+		}
+
+		err := NewCancelAllOrdersService("key", "secret").WithClient(fakeClient).Do(context.Background())
+		assert.NoError(t, err)
+	})
+
+	t.Run("request failed", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				return nil, errors.New("network is down")
+			},
+		}
+
+		err := NewCancelAllOrdersService("key", "secret").WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+
+		var sdkErr *sdkerr.SDKError
+		require.ErrorAs(t, err, &sdkErr)
+		assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+	})
+}
@@ -0,0 +1,183 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+type planOrderRef struct {
+	Symbol  string `json:"symbol"`
+	OrderID string `json:"orderId"`
+}
+
+// CancelPlanOrderService cancels plan orders.
+type CancelPlanOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	orders     []planOrderRef
+}
+
+// NewCancelPlanOrderService creates a new CancelPlanOrderService.
+func NewCancelPlanOrderService(apiKey, secretKey string) *CancelPlanOrderService {
+	return &CancelPlanOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelPlanOrderService) WithClient(client transport.HTTPClient) *CancelPlanOrderService {
+	s.client = client
+	return s
+}
+
+// Order adds a plan order to cancel. It may be called multiple times.
+func (s *CancelPlanOrderService) Order(symbol, orderID string) *CancelPlanOrderService {
+	s.orders = append(s.orders, planOrderRef{Symbol: symbol, OrderID: orderID})
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelPlanOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelPlanOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelPlanOrderService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/planorder/cancel").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelPlanOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelPlanOrderService) validate() error {
+	var errs []string
+
+	if len(s.orders) == 0 {
+		errs = append(errs, "at least one order is required")
+	}
+
+	if len(s.orders) > maxCancelOrders {
+		errs = append(errs, fmt.Sprintf("no more than %d orders are allowed", maxCancelOrders))
+	}
+
+	for i, o := range s.orders {
+		if o.Symbol == "" {
+			errs = append(errs, fmt.Sprintf("order %d: symbol is required", i))
+		}
+		if o.OrderID == "" {
+			errs = append(errs, fmt.Sprintf("order %d: orderId is required", i))
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelPlanOrderService) buildBody() []byte {
+	body, _ := json.Marshal(s.orders)
+	return body
+}
+
+// CancelAllPlanOrdersService cancels all plan orders, optionally limited to one contract.
+type CancelAllPlanOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewCancelAllPlanOrdersService creates a new CancelAllPlanOrdersService.
+func NewCancelAllPlanOrdersService(apiKey, secretKey string) *CancelAllPlanOrdersService {
+	return &CancelAllPlanOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelAllPlanOrdersService) WithClient(client transport.HTTPClient) *CancelAllPlanOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the cancellation to a single contract.
+func (s *CancelAllPlanOrdersService) Symbol(symbol string) *CancelAllPlanOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *CancelAllPlanOrdersService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/planorder/cancel_all").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelAllPlanOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelAllPlanOrdersService) buildBody() []byte {
+	params := make(map[string]string)
+	if s.symbol != nil {
+		params["symbol"] = *s.symbol
+	}
+	body, _ := json.Marshal(params)
+	return body
+}
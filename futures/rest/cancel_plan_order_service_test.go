@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelPlanOrderService(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		err := NewCancelPlanOrderService("key", "secret").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one order is required")
+
+		err = NewCancelPlanOrderService("key", "secret").Order("", "1").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "order 0: symbol is required")
+	})
+
+	t.Run("do", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				assert.Contains(t, req.FullURL, "/api/v1/private/planorder/cancel")
+
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `[{"symbol":"BTC_USDT","orderId":"1"},{"symbol":"ETH_USDT","orderId":"2"}]`, string(body))
+
+				return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+			},
+		}
+
+		err := NewCancelPlanOrderService("key", "secret").
+			WithClient(fakeClient).
+			Order("BTC_USDT", "1").
+			Order("ETH_USDT", "2").
+			Do(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func TestCancelAllPlanOrdersService_Do(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Contains(t, req.FullURL, "/api/v1/private/planorder/cancel_all")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"symbol":"BTC_USDT"}`, string(body))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewCancelAllPlanOrdersService("key", "secret").WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,188 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+type stopOrderRef struct {
+	StopPlanOrderID int64 `json:"stopPlanOrderId"`
+}
+
+// CancelStopOrderService cancels stop orders by their IDs.
+type CancelStopOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	ids        []int64
+}
+
+// NewCancelStopOrderService creates a new CancelStopOrderService.
+func NewCancelStopOrderService(apiKey, secretKey string) *CancelStopOrderService {
+	return &CancelStopOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelStopOrderService) WithClient(client transport.HTTPClient) *CancelStopOrderService {
+	s.client = client
+	return s
+}
+
+// StopPlanOrderIDs sets the IDs of the stop orders to cancel.
+func (s *CancelStopOrderService) StopPlanOrderIDs(ids ...int64) *CancelStopOrderService {
+	s.ids = ids
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelStopOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelStopOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelStopOrderService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/stoporder/cancel").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelStopOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelStopOrderService) validate() error {
+	var errs []string
+
+	if len(s.ids) == 0 {
+		errs = append(errs, "at least one stopPlanOrderId is required")
+	}
+
+	if len(s.ids) > maxCancelOrders {
+		errs = append(errs, fmt.Sprintf("no more than %d orders are allowed", maxCancelOrders))
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelStopOrderService) buildBody() []byte {
+	refs := make([]stopOrderRef, len(s.ids))
+	for i, id := range s.ids {
+		refs[i] = stopOrderRef{StopPlanOrderID: id}
+	}
+	body, _ := json.Marshal(refs)
+	return body
+}
+
+// CancelAllStopOrdersService cancels all stop orders, optionally limited to
+// one position or contract.
+type CancelAllStopOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	positionID *int64
+	symbol     *string
+}
+
+// NewCancelAllStopOrdersService creates a new CancelAllStopOrdersService.
+func NewCancelAllStopOrdersService(apiKey, secretKey string) *CancelAllStopOrdersService {
+	return &CancelAllStopOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelAllStopOrdersService) WithClient(client transport.HTTPClient) *CancelAllStopOrdersService {
+	s.client = client
+	return s
+}
+
+// PositionID limits the cancellation to a single position.
+func (s *CancelAllStopOrdersService) PositionID(id int64) *CancelAllStopOrdersService {
+	s.positionID = &id
+	return s
+}
+
+// Symbol limits the cancellation to a single contract.
+func (s *CancelAllStopOrdersService) Symbol(symbol string) *CancelAllStopOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *CancelAllStopOrdersService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/stoporder/cancel_all").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "CancelAllStopOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *CancelAllStopOrdersService) buildBody() []byte {
+	params := struct {
+		PositionID *int64  `json:"positionId,omitempty"`
+		Symbol     *string `json:"symbol,omitempty"`
+	}{
+		PositionID: s.positionID,
+		Symbol:     s.symbol,
+	}
+	body, _ := json.Marshal(params)
+	return body
+}
@@ -0,0 +1,54 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelStopOrderService(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		err := NewCancelStopOrderService("key", "secret").validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one stopPlanOrderId is required")
+	})
+
+	t.Run("do", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				assert.Contains(t, req.FullURL, "/api/v1/private/stoporder/cancel")
+
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `[{"stopPlanOrderId":1},{"stopPlanOrderId":2}]`, string(body))
+
+				return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+			},
+		}
+
+		err := NewCancelStopOrderService("key", "secret").WithClient(fakeClient).StopPlanOrderIDs(1, 2).Do(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func TestCancelAllStopOrdersService_Do(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Contains(t, req.FullURL, "/api/v1/private/stoporder/cancel_all")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"positionId":1394650}`, string(body))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewCancelAllStopOrdersService("key", "secret").WithClient(fakeClient).PositionID(1394650).Do(context.Background())
+	assert.NoError(t, err)
+}
@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Contract represents the specification of a futures contract.
+type Contract struct {
+	Symbol                     string          `json:"symbol"`
+	DisplayName                string          `json:"displayName"`
+	DisplayNameEn              string          `json:"displayNameEn"`
+	PositionOpenType           int             `json:"positionOpenType"`
+	BaseCoin                   string          `json:"baseCoin"`
+	QuoteCoin                  string          `json:"quoteCoin"`
+	SettleCoin                 string          `json:"settleCoin"`
+	ContractSize               decimal.Decimal `json:"contractSize"`
+	MinLeverage                int             `json:"minLeverage"`
+	MaxLeverage                int             `json:"maxLeverage"`
+	PriceScale                 int             `json:"priceScale"`
+	VolScale                   int             `json:"volScale"`
+	AmountScale                int             `json:"amountScale"`
+	PriceUnit                  decimal.Decimal `json:"priceUnit"`
+	VolUnit                    decimal.Decimal `json:"volUnit"`
+	MinVol                     decimal.Decimal `json:"minVol"`
+	MaxVol                     decimal.Decimal `json:"maxVol"`
+	BidLimitPriceRate          decimal.Decimal `json:"bidLimitPriceRate"`
+	AskLimitPriceRate          decimal.Decimal `json:"askLimitPriceRate"`
+	TakerFeeRate               decimal.Decimal `json:"takerFeeRate"`
+	MakerFeeRate               decimal.Decimal `json:"makerFeeRate"`
+	MaintenanceMarginRate      decimal.Decimal `json:"maintenanceMarginRate"`
+	InitialMarginRate          decimal.Decimal `json:"initialMarginRate"`
+	RiskBaseVol                decimal.Decimal `json:"riskBaseVol"`
+	RiskIncrVol                decimal.Decimal `json:"riskIncrVol"`
+	RiskIncrMmr                decimal.Decimal `json:"riskIncrMmr"`
+	RiskIncrImr                decimal.Decimal `json:"riskIncrImr"`
+	RiskLevelLimit             int             `json:"riskLevelLimit"`
+	PriceCoefficientVariation  decimal.Decimal `json:"priceCoefficientVariation"`
+	IndexOrigin                []string        `json:"indexOrigin"`
+	State                      ContractState   `json:"state"`
+	IsNew                      bool            `json:"isNew"`
+	IsHot                      bool            `json:"isHot"`
+	IsHidden                   bool            `json:"isHidden"`
+	ConceptPlate               []string        `json:"conceptPlate"`
+	RiskLimitType              string          `json:"riskLimitType"`
+	MaxNumOrders               []int           `json:"maxNumOrders"`
+	MarketOrderMaxLevel        int             `json:"marketOrderMaxLevel"`
+	MarketOrderPriceLimitRate1 decimal.Decimal `json:"marketOrderPriceLimitRate1"`
+	MarketOrderPriceLimitRate2 decimal.Decimal `json:"marketOrderPriceLimitRate2"`
+	TriggerProtect             decimal.Decimal `json:"triggerProtect"`
+	APIAllowed                 bool            `json:"apiAllowed"`
+}
+
+// ContractDetailService gets the specification of one or all contracts.
+type ContractDetailService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewContractDetailService creates a new ContractDetailService.
+func NewContractDetailService() *ContractDetailService {
+	return &ContractDetailService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ContractDetailService) WithClient(client transport.HTTPClient) *ContractDetailService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *ContractDetailService) Symbol(symbol string) *ContractDetailService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *ContractDetailService) Do(ctx context.Context) ([]Contract, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/contract/detail").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "ContractDetailService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeOneOrMany[Contract](resp.Body, s.symbol != nil, op)
+}
+
+func (s *ContractDetailService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	return q
+}
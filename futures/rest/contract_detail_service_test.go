@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const contractJSON = `{
+	"symbol": "BTC_USDT",
+	"displayName": "BTC_USDT永续",
+	"displayNameEn": "BTC_USDT PERPETUAL",
+	"positionOpenType": 3,
+	"baseCoin": "BTC",
+	"quoteCoin": "USDT",
+	"settleCoin": "USDT",
+	"contractSize": 0.0001,
+	"minLeverage": 1,
+	"maxLeverage": 125,
+	"priceScale": 1,
+	"volScale": 0,
+	"amountScale": 4,
+	"priceUnit": 0.1,
+	"volUnit": 1,
+	"minVol": 1,
+	"maxVol": 1150000,
+	"bidLimitPriceRate": 0.1,
+	"askLimitPriceRate": 0.1,
+	"takerFeeRate": 0.0002,
+	"makerFeeRate": 0,
+	"maintenanceMarginRate": 0.004,
+	"initialMarginRate": 0.008,
+	"riskBaseVol": 1150000,
+	"riskIncrVol": 1150000,
+	"riskIncrMmr": 0.004,
+	"riskIncrImr": 0.004,
+	"riskLevelLimit": 5,
+	"priceCoefficientVariation": 0.1,
+	"indexOrigin": ["BINANCE", "GATEIO"],
+	"state": 0,
+	"isNew": false,
+	"isHot": true,
+	"isHidden": false,
+	"conceptPlate": ["mc-trade-zone-pow"],
+	"riskLimitType": "BY_VOLUME",
+	"maxNumOrders": [200, 50],
+	"marketOrderMaxLevel": 20,
+	"marketOrderPriceLimitRate1": 0.2,
+	"marketOrderPriceLimitRate2": 0.005,
+	"triggerProtect": 0.1,
+	"apiAllowed": true
+}`
+
+func TestContractDetailService_buildQuery(t *testing.T) {
+	q := NewContractDetailService().buildQuery()
+	assert.False(t, q.Has("symbol"))
+
+	q = NewContractDetailService().Symbol("BTC_USDT").buildQuery()
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+}
+
+func TestContractDetailService_Do_Single(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/detail")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTC_USDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":` + contractJSON + `}`),
+			}, nil
+		},
+	}
+
+	result, err := NewContractDetailService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	c := result[0]
+	assert.Equal(t, "BTC_USDT", c.Symbol)
+	assert.Equal(t, "USDT", c.SettleCoin)
+	assert.Equal(t, 125, c.MaxLeverage)
+	assert.Equal(t, ContractStateEnabled, c.State)
+	assert.Equal(t, []string{"BINANCE", "GATEIO"}, c.IndexOrigin)
+	assert.True(t, c.APIAllowed)
+	testutil.AssertDecimalEqual(t, c.ContractSize, "0.0001", "contract size mismatch")
+	testutil.AssertDecimalEqual(t, c.PriceUnit, "0.1", "price unit mismatch")
+	testutil.AssertDecimalEqual(t, c.TakerFeeRate, "0.0002", "taker fee mismatch")
+}
+
+func TestContractDetailService_Do_All(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + contractJSON + `,` + contractJSON + `]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewContractDetailService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+}
@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Deal represents a single executed trade.
+type Deal struct {
+	Price          decimal.Decimal
+	Volume         decimal.Decimal
+	Side           TradeSide
+	OpenType       DealOpenType
+	IsAutoTransact AutoTransact
+	Timestamp      int64
+}
+
+type dealJSON struct {
+	Price        decimal.Decimal `json:"p"`
+	Volume       decimal.Decimal `json:"v"`
+	Side         int             `json:"T"`
+	OpenType     int             `json:"O"`
+	AutoTransact int             `json:"M"`
+	Timestamp    int64           `json:"t"`
+}
+
+func (d *Deal) UnmarshalJSON(data []byte) error {
+	var tmp dealJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	side, err := parseTradeSide(tmp.Side)
+	if err != nil {
+		return err
+	}
+	openType, err := parseDealOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+	autoTransact, err := parseAutoTransact(tmp.AutoTransact)
+	if err != nil {
+		return err
+	}
+
+	d.Price = tmp.Price
+	d.Volume = tmp.Volume
+	d.Side = side
+	d.OpenType = openType
+	d.IsAutoTransact = autoTransact
+	d.Timestamp = tmp.Timestamp
+	return nil
+}
+
+// DealsService gets the recent trades of a symbol.
+type DealsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	limit      *int
+}
+
+// NewDealsService creates a new DealsService.
+func NewDealsService() *DealsService {
+	return &DealsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DealsService) WithClient(client transport.HTTPClient) *DealsService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the trades.
+func (s *DealsService) Symbol(symbol string) *DealsService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit sets the number of trades to return (max 100).
+func (s *DealsService) Limit(n int) *DealsService {
+	s.limit = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DealsService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DealsService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DealsService) Do(ctx context.Context) ([]Deal, error) {
+	path := fmt.Sprintf("/api/v1/contract/deals/%s", s.symbol)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DealsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	deals, err := decodeResponse[[]Deal](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *deals, nil
+}
+
+func (s *DealsService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 100) {
+		errs = append(errs, "limit must be between 1 and 100")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DealsService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	return q
+}
@@ -0,0 +1,73 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDealsService_validate(t *testing.T) {
+	assert.NoError(t, NewDealsService().Symbol("BTC_USDT").Limit(100).validate())
+
+	err := NewDealsService().Limit(101).validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "symbol is required")
+	assert.Contains(t, err.Error(), "limit must be between 1 and 100")
+}
+
+func TestDealsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/deals/BTC_USDT")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "2", query.Get("limit"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[
+					{"p":31199,"v":18,"T":1,"O":3,"M":2,"t":1609831235985},
+					{"p":31198.5,"v":4,"T":2,"O":1,"M":1,"t":1609831235990}
+				]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewDealsService().WithClient(fakeClient).Symbol("BTC_USDT").Limit(2).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, TradeSideBuy, result[0].Side)
+	assert.Equal(t, DealOpenTypeNoChange, result[0].OpenType)
+	assert.Equal(t, AutoTransactNo, result[0].IsAutoTransact)
+	testutil.AssertDecimalEqual(t, result[0].Price, "31199", "price mismatch")
+	testutil.AssertDecimalEqual(t, result[0].Volume, "18", "volume mismatch")
+
+	assert.Equal(t, TradeSideSell, result[1].Side)
+	assert.Equal(t, DealOpenTypeOpen, result[1].OpenType)
+	assert.Equal(t, int64(1609831235990), result[1].Timestamp)
+}
+
+func TestDealsService_Do_InvalidCode(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":[{"p":1,"v":1,"T":9,"O":1,"M":1,"t":1}]}`),
+			}, nil
+		},
+	}
+
+	_, err := NewDealsService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
@@ -0,0 +1,106 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// DepthCommitsService gets the most recent order book snapshots for a symbol.
+type DepthCommitsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	limit      int
+}
+
+// NewDepthCommitsService creates a new DepthCommitsService.
+func NewDepthCommitsService() *DepthCommitsService {
+	return &DepthCommitsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DepthCommitsService) WithClient(client transport.HTTPClient) *DepthCommitsService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the snapshots.
+func (s *DepthCommitsService) Symbol(symbol string) *DepthCommitsService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit sets the number of snapshots to return.
+func (s *DepthCommitsService) Limit(n int) *DepthCommitsService {
+	s.limit = n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DepthCommitsService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DepthCommitsService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DepthCommitsService) Do(ctx context.Context) ([]OrderBookDepths, error) {
+	path := fmt.Sprintf("/api/v1/contract/depth_commits/%s/%d", s.symbol, s.limit)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "DepthCommitsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	commits, err := decodeResponse[[]OrderBookDepths](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *commits, nil
+}
+
+func (s *DepthCommitsService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.limit < 1 {
+		errs = append(errs, "limit must be above 0")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDepthCommitsService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDepthCommitsService().Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "DepthCommitsService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+	assert.Contains(t, sdkErr.Message(), "limit must be above 0")
+}
+
+func TestDepthCommitsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/depth_commits/BTC_USDT/2")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"success": true,
+					"code": 0,
+					"data": [
+						{"asks": [[30971.7, 0, 0]], "bids": [], "version": 2622334332},
+						{"asks": [], "bids": [[30971.6, 2594, 1]], "version": 2622334333}
+					]
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewDepthCommitsService().WithClient(fakeClient).Symbol("BTC_USDT").Limit(2).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, int64(2622334332), result[0].Version)
+	require.Len(t, result[0].Asks, 1)
+	assert.True(t, result[0].Asks[0].Quantity.IsZero())
+
+	require.Len(t, result[1].Bids, 1)
+	testutil.AssertDecimalEqual(t, result[1].Bids[0].Price, "30971.6", "bid price mismatch")
+}
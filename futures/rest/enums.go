@@ -0,0 +1,399 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+)
+
+// KlineInterval represents the server-defined candlestick interval.
+type KlineInterval string
+
+const (
+	Kline1Min   KlineInterval = "Min1"
+	Kline5Min   KlineInterval = "Min5"
+	Kline15Min  KlineInterval = "Min15"
+	Kline30Min  KlineInterval = "Min30"
+	Kline60Min  KlineInterval = "Min60"
+	Kline4Hour  KlineInterval = "Hour4"
+	Kline8Hour  KlineInterval = "Hour8"
+	Kline1Day   KlineInterval = "Day1"
+	Kline1Week  KlineInterval = "Week1"
+	Kline1Month KlineInterval = "Month1"
+)
+
+// TradeSide indicates whether the trade was buyer or seller initiated.
+type TradeSide string
+
+const (
+	TradeSideBuy  TradeSide = "BUY"
+	TradeSideSell TradeSide = "SELL"
+)
+
+// DealOpenType indicates whether the trade was an open or close position.
+type DealOpenType string
+
+const (
+	DealOpenTypeOpen     DealOpenType = "OPEN"
+	DealOpenTypeClose    DealOpenType = "CLOSE"
+	DealOpenTypeNoChange DealOpenType = "NO_CHANGE"
+)
+
+// AutoTransact indicates whether the trade was an auto-transact.
+type AutoTransact string
+
+const (
+	AutoTransactYes AutoTransact = "YES"
+	AutoTransactNo  AutoTransact = "NO"
+)
+
+// ContractState represents the trading state of a contract.
+type ContractState int
+
+const (
+	ContractStateEnabled   ContractState = 0
+	ContractStateDelivery  ContractState = 1
+	ContractStateCompleted ContractState = 2
+	ContractStateOffline   ContractState = 3
+	ContractStatePaused    ContractState = 4
+)
+
+func (k KlineInterval) isValid() bool {
+	switch k {
+	case Kline1Min, Kline5Min, Kline15Min, Kline30Min, Kline60Min,
+		Kline4Hour, Kline8Hour, Kline1Day, Kline1Week, Kline1Month:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s ContractState) isValid() bool {
+	return s >= ContractStateEnabled && s <= ContractStatePaused
+}
+
+func (s *ContractState) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+
+	parsed := ContractState(n)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid contract state: %d", n)
+	}
+
+	*s = parsed
+	return nil
+}
+
+func parseTradeSide(code int) (TradeSide, error) {
+	switch code {
+	case 1:
+		return TradeSideBuy, nil
+	case 2:
+		return TradeSideSell, nil
+	default:
+		return "", fmt.Errorf("unknown trade side code: %d", code)
+	}
+}
+
+func parseDealOpenType(code int) (DealOpenType, error) {
+	switch code {
+	case 1:
+		return DealOpenTypeOpen, nil
+	case 2:
+		return DealOpenTypeClose, nil
+	case 3:
+		return DealOpenTypeNoChange, nil
+	default:
+		return "", fmt.Errorf("unknown open type code: %d", code)
+	}
+}
+
+func parseAutoTransact(code int) (AutoTransact, error) {
+	switch code {
+	case 1:
+		return AutoTransactYes, nil
+	case 2:
+		return AutoTransactNo, nil
+	default:
+		return "", fmt.Errorf("unknown auto transact code: %d", code)
+	}
+}
+
+// positionTypeCode returns the REST code of t. Positions reuse the
+// futures/wsuser enums so that they compare equal to PositionSub pushes.
+func positionTypeCode(t wsuser.PositionType) (int, bool) {
+	switch t {
+	case wsuser.PositionTypeLong:
+		return 1, true
+	case wsuser.PositionTypeShort:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+func openTypeCode(t wsuser.OpenType) (int, bool) {
+	switch t {
+	case wsuser.OpenTypeIsolated:
+		return 1, true
+	case wsuser.OpenTypeCross:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// orderSideCode returns the REST code of s. Orders reuse the futures/wsuser
+// enums so that they compare equal to OrderSub pushes.
+func orderSideCode(s wsuser.OrderSide) (int, bool) {
+	switch s {
+	case wsuser.OrderSideOpenLong:
+		return 1, true
+	case wsuser.OrderSideCloseShort:
+		return 2, true
+	case wsuser.OrderSideOpenShort:
+		return 3, true
+	case wsuser.OrderSideCloseLong:
+		return 4, true
+	default:
+		return 0, false
+	}
+}
+
+func isOpenSide(s wsuser.OrderSide) bool {
+	return s == wsuser.OrderSideOpenLong || s == wsuser.OrderSideOpenShort
+}
+
+func orderTypeCode(t wsuser.OrderType) (int, bool) {
+	switch t {
+	case wsuser.OrderTypeLimit:
+		return 1, true
+	case wsuser.OrderTypeLimitMaker:
+		return 2, true
+	case wsuser.OrderTypeImmediateOrCancel:
+		return 3, true
+	case wsuser.OrderTypeFillOrKill:
+		return 4, true
+	case wsuser.OrderTypeMarket:
+		return 5, true
+	case wsuser.OrderTypeMarketToLimit:
+		return 6, true
+	default:
+		return 0, false
+	}
+}
+
+func orderCategoryCode(c wsuser.OrderCategory) (int, bool) {
+	switch c {
+	case wsuser.OrderCategoryLimitOrder:
+		return 1, true
+	case wsuser.OrderCategorySystemTakeOverDelegate:
+		return 2, true
+	case wsuser.OrderCategoryCloseDelegate:
+		return 3, true
+	case wsuser.OrderCategoryAdlReduction:
+		return 4, true
+	default:
+		return 0, false
+	}
+}
+
+func orderStateCode(s wsuser.OrderState) (int, bool) {
+	switch s {
+	case wsuser.OrderStateUninformed:
+		return 1, true
+	case wsuser.OrderStateUncompleted:
+		return 2, true
+	case wsuser.OrderStateCompleted:
+		return 3, true
+	case wsuser.OrderStateCancelled:
+		return 4, true
+	case wsuser.OrderStateInvalid:
+		return 5, true
+	default:
+		return 0, false
+	}
+}
+
+// TriggerType represents the comparison that fires a plan order.
+type TriggerType string
+
+const (
+	TriggerTypeGreaterOrEqual TriggerType = "GREATER_OR_EQUAL"
+	TriggerTypeLessOrEqual    TriggerType = "LESS_OR_EQUAL"
+)
+
+// TriggerPriceSource represents the price a trigger is compared against.
+type TriggerPriceSource string
+
+const (
+	TriggerPriceSourceLast  TriggerPriceSource = "LAST_PRICE"
+	TriggerPriceSourceFair  TriggerPriceSource = "FAIR_PRICE"
+	TriggerPriceSourceIndex TriggerPriceSource = "INDEX_PRICE"
+)
+
+// ExecuteCycle represents how long a plan order stays active.
+type ExecuteCycle string
+
+const (
+	ExecuteCycle24Hours ExecuteCycle = "24_HOURS"
+	ExecuteCycle7Days   ExecuteCycle = "7_DAYS"
+)
+
+// PlanOrderState represents the state of a plan or stop order.
+type PlanOrderState string
+
+const (
+	PlanOrderStateUntriggered     PlanOrderState = "UNTRIGGERED"
+	PlanOrderStateCancelled       PlanOrderState = "CANCELLED"
+	PlanOrderStateExecuted        PlanOrderState = "EXECUTED"
+	PlanOrderStateInvalid         PlanOrderState = "INVALID"
+	PlanOrderStateExecutionFailed PlanOrderState = "EXECUTION_FAILED"
+)
+
+// StopTriggerSide indicates which leg of a stop order fired.
+type StopTriggerSide string
+
+const (
+	StopTriggerSideNone       StopTriggerSide = "NONE"
+	StopTriggerSideTakeProfit StopTriggerSide = "TAKE_PROFIT"
+	StopTriggerSideStopLoss   StopTriggerSide = "STOP_LOSS"
+)
+
+func (t TriggerType) code() (int, bool) {
+	switch t {
+	case TriggerTypeGreaterOrEqual:
+		return 1, true
+	case TriggerTypeLessOrEqual:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+func (s TriggerPriceSource) code() (int, bool) {
+	switch s {
+	case TriggerPriceSourceLast:
+		return 1, true
+	case TriggerPriceSourceFair:
+		return 2, true
+	case TriggerPriceSourceIndex:
+		return 3, true
+	default:
+		return 0, false
+	}
+}
+
+func (c ExecuteCycle) code() (int, bool) {
+	switch c {
+	case ExecuteCycle24Hours:
+		return 1, true
+	case ExecuteCycle7Days:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+func (s PlanOrderState) code() (int, bool) {
+	switch s {
+	case PlanOrderStateUntriggered:
+		return 1, true
+	case PlanOrderStateCancelled:
+		return 2, true
+	case PlanOrderStateExecuted:
+		return 3, true
+	case PlanOrderStateInvalid:
+		return 4, true
+	case PlanOrderStateExecutionFailed:
+		return 5, true
+	default:
+		return 0, false
+	}
+}
+
+func parseTriggerType(code int) (TriggerType, error) {
+	switch code {
+	case 1:
+		return TriggerTypeGreaterOrEqual, nil
+	case 2:
+		return TriggerTypeLessOrEqual, nil
+	default:
+		return "", fmt.Errorf("unknown trigger type code: %d", code)
+	}
+}
+
+func parseTriggerPriceSource(code int) (TriggerPriceSource, error) {
+	switch code {
+	case 1:
+		return TriggerPriceSourceLast, nil
+	case 2:
+		return TriggerPriceSourceFair, nil
+	case 3:
+		return TriggerPriceSourceIndex, nil
+	default:
+		return "", fmt.Errorf("unknown trigger price source code: %d", code)
+	}
+}
+
+func parseExecuteCycle(code int) (ExecuteCycle, error) {
+	switch code {
+	case 1:
+		return ExecuteCycle24Hours, nil
+	case 2:
+		return ExecuteCycle7Days, nil
+	default:
+		return "", fmt.Errorf("unknown execute cycle code: %d", code)
+	}
+}
+
+func parsePlanOrderState(code int) (PlanOrderState, error) {
+	switch code {
+	case 1:
+		return PlanOrderStateUntriggered, nil
+	case 2:
+		return PlanOrderStateCancelled, nil
+	case 3:
+		return PlanOrderStateExecuted, nil
+	case 4:
+		return PlanOrderStateInvalid, nil
+	case 5:
+		return PlanOrderStateExecutionFailed, nil
+	default:
+		return "", fmt.Errorf("unknown plan order state code: %d", code)
+	}
+}
+
+func parseStopTriggerSide(code int) (StopTriggerSide, error) {
+	switch code {
+	case 0:
+		return StopTriggerSideNone, nil
+	case 1:
+		return StopTriggerSideTakeProfit, nil
+	case 2:
+		return StopTriggerSideStopLoss, nil
+	default:
+		return "", fmt.Errorf("unknown stop trigger side code: %d", code)
+	}
+}
+
+// MarginChangeType indicates whether isolated margin is added or removed.
+type MarginChangeType string
+
+const (
+	MarginChangeAdd    MarginChangeType = "ADD"
+	MarginChangeReduce MarginChangeType = "SUB"
+)
+
+func (t MarginChangeType) isValid() bool {
+	switch t {
+	case MarginChangeAdd, MarginChangeReduce:
+		return true
+	default:
+		return false
+	}
+}
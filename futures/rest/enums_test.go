@@ -0,0 +1,154 @@
+package rest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKlineInterval_isValid(t *testing.T) {
+	assert.True(t, Kline1Min.isValid())
+	assert.True(t, Kline1Month.isValid())
+	assert.False(t, KlineInterval("1m").isValid())
+}
+
+func TestContractState_UnmarshalJSON(t *testing.T) {
+	var state ContractState
+
+	t.Run("valid state", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`4`), &state)
+		assert.NoError(t, err)
+		assert.Equal(t, ContractStatePaused, state)
+	})
+
+	t.Run("invalid state", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`9`), &state)
+		assert.Error(t, err)
+	})
+}
+
+func TestParseTradeCodes(t *testing.T) {
+	side, err := parseTradeSide(2)
+	assert.NoError(t, err)
+	assert.Equal(t, TradeSideSell, side)
+
+	openType, err := parseDealOpenType(3)
+	assert.NoError(t, err)
+	assert.Equal(t, DealOpenTypeNoChange, openType)
+
+	auto, err := parseAutoTransact(1)
+	assert.NoError(t, err)
+	assert.Equal(t, AutoTransactYes, auto)
+
+	_, err = parseTradeSide(0)
+	assert.Error(t, err)
+	_, err = parseDealOpenType(0)
+	assert.Error(t, err)
+	_, err = parseAutoTransact(0)
+	assert.Error(t, err)
+}
+
+func TestPositionTypeCode(t *testing.T) {
+	code, ok := positionTypeCode(wsuser.PositionTypeLong)
+	assert.True(t, ok)
+	assert.Equal(t, 1, code)
+
+	_, ok = positionTypeCode(wsuser.PositionType("BOTH"))
+	assert.False(t, ok)
+
+	code, ok = openTypeCode(wsuser.OpenTypeCross)
+	assert.True(t, ok)
+	assert.Equal(t, 2, code)
+
+	_, ok = openTypeCode(wsuser.OpenType("BOTH"))
+	assert.False(t, ok)
+}
+
+func TestOrderEnums_codeRoundTrip(t *testing.T) {
+	for _, side := range []wsuser.OrderSide{wsuser.OrderSideOpenLong, wsuser.OrderSideCloseShort, wsuser.OrderSideOpenShort, wsuser.OrderSideCloseLong} {
+		code, ok := orderSideCode(side)
+		assert.True(t, ok)
+		parsed, err := wsuser.ParseOrderSide(code)
+		assert.NoError(t, err)
+		assert.Equal(t, side, parsed)
+	}
+
+	for _, orderType := range []wsuser.OrderType{
+		wsuser.OrderTypeLimit, wsuser.OrderTypeMarket, wsuser.OrderTypeLimitMaker,
+		wsuser.OrderTypeImmediateOrCancel, wsuser.OrderTypeFillOrKill, wsuser.OrderTypeMarketToLimit,
+	} {
+		code, ok := orderTypeCode(orderType)
+		assert.True(t, ok)
+		parsed, err := wsuser.ParseOrderType(code)
+		assert.NoError(t, err)
+		assert.Equal(t, orderType, parsed)
+	}
+
+	for _, state := range []wsuser.OrderState{
+		wsuser.OrderStateUninformed, wsuser.OrderStateUncompleted, wsuser.OrderStateCompleted,
+		wsuser.OrderStateCancelled, wsuser.OrderStateInvalid,
+	} {
+		code, ok := orderStateCode(state)
+		assert.True(t, ok)
+		parsed, err := wsuser.ParseOrderState(code)
+		assert.NoError(t, err)
+		assert.Equal(t, state, parsed)
+	}
+
+	_, ok := orderSideCode(wsuser.OrderSide("BUY"))
+	assert.False(t, ok)
+	_, ok = orderTypeCode(wsuser.OrderType("STOP"))
+	assert.False(t, ok)
+}
+
+func TestTriggerEnums_codeRoundTrip(t *testing.T) {
+	for _, tt := range []TriggerType{TriggerTypeGreaterOrEqual, TriggerTypeLessOrEqual} {
+		code, ok := tt.code()
+		assert.True(t, ok)
+		parsed, err := parseTriggerType(code)
+		assert.NoError(t, err)
+		assert.Equal(t, tt, parsed)
+	}
+
+	for _, src := range []TriggerPriceSource{TriggerPriceSourceLast, TriggerPriceSourceFair, TriggerPriceSourceIndex} {
+		code, ok := src.code()
+		assert.True(t, ok)
+		parsed, err := parseTriggerPriceSource(code)
+		assert.NoError(t, err)
+		assert.Equal(t, src, parsed)
+	}
+
+	for _, cycle := range []ExecuteCycle{ExecuteCycle24Hours, ExecuteCycle7Days} {
+		code, ok := cycle.code()
+		assert.True(t, ok)
+		parsed, err := parseExecuteCycle(code)
+		assert.NoError(t, err)
+		assert.Equal(t, cycle, parsed)
+	}
+
+	for _, state := range []PlanOrderState{
+		PlanOrderStateUntriggered, PlanOrderStateCancelled, PlanOrderStateExecuted,
+		PlanOrderStateInvalid, PlanOrderStateExecutionFailed,
+	} {
+		code, ok := state.code()
+		assert.True(t, ok)
+		parsed, err := parsePlanOrderState(code)
+		assert.NoError(t, err)
+		assert.Equal(t, state, parsed)
+	}
+
+	side, err := parseStopTriggerSide(2)
+	assert.NoError(t, err)
+	assert.Equal(t, StopTriggerSideStopLoss, side)
+
+	_, ok := TriggerType("EQ").code()
+	assert.False(t, ok)
+	_, ok = TriggerPriceSource("MARK").code()
+	assert.False(t, ok)
+	_, ok = ExecuteCycle("30_DAYS").code()
+	assert.False(t, ok)
+	_, err = parseStopTriggerSide(3)
+	assert.Error(t, err)
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// FairPrice represents the fair (mark) price of a contract.
+type FairPrice struct {
+	Symbol    string          `json:"symbol"`
+	FairPrice decimal.Decimal `json:"fairPrice"`
+	Timestamp int64           `json:"timestamp"`
+}
+
+// FairPriceService gets the fair price of a symbol.
+type FairPriceService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewFairPriceService creates a new FairPriceService.
+func NewFairPriceService() *FairPriceService {
+	return &FairPriceService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *FairPriceService) WithClient(client transport.HTTPClient) *FairPriceService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the fair price.
+func (s *FairPriceService) Symbol(symbol string) *FairPriceService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *FairPriceService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("FairPriceService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *FairPriceService) Do(ctx context.Context) (*FairPrice, error) {
+	path := fmt.Sprintf("/api/v1/contract/fair_price/%s", s.symbol)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "FairPriceService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[FairPrice](resp.Body, op)
+}
+
+func (s *FairPriceService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
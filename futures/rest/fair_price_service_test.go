@@ -0,0 +1,40 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFairPriceService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewFairPriceService().Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "FairPriceService.Validate", sdkErr.Op())
+}
+
+func TestFairPriceService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/fair_price/BTC_USDT")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":{"symbol":"BTC_USDT","fairPrice":30972.1,"timestamp":1658996910762}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewFairPriceService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	testutil.AssertDecimalEqual(t, result.FairPrice, "30972.1", "fair price mismatch")
+}
@@ -0,0 +1,105 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// TieredFeeRate represents the fee tier of the account for a contract.
+type TieredFeeRate struct {
+	Level            int             `json:"level"`
+	DealAmount       decimal.Decimal `json:"dealAmount"`
+	WalletBalance    decimal.Decimal `json:"walletBalance"`
+	MakerFee         decimal.Decimal `json:"makerFee"`
+	TakerFee         decimal.Decimal `json:"takerFee"`
+	MakerFeeDiscount decimal.Decimal `json:"makerFeeDiscount"`
+	TakerFeeDiscount decimal.Decimal `json:"takerFeeDiscount"`
+}
+
+// TieredFeeRateService gets the fee tier and fee rates of the account for a contract.
+type TieredFeeRateService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewTieredFeeRateService creates a new TieredFeeRateService.
+func NewTieredFeeRateService(apiKey, secretKey string) *TieredFeeRateService {
+	return &TieredFeeRateService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TieredFeeRateService) WithClient(client transport.HTTPClient) *TieredFeeRateService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract.
+func (s *TieredFeeRateService) Symbol(symbol string) *TieredFeeRateService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *TieredFeeRateService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("TieredFeeRateService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *TieredFeeRateService) Do(ctx context.Context) (*TieredFeeRate, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/account/tiered_fee_rate").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TieredFeeRateService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[TieredFeeRate](resp.Body, op)
+}
+
+func (s *TieredFeeRateService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
+
+func (s *TieredFeeRateService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	return q
+}
@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTieredFeeRateService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewTieredFeeRateService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, "TieredFeeRateService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+}
+
+func TestTieredFeeRateService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/account/tiered_fee_rate")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTC_USDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":{"level":1,"dealAmount":12000.5,
+					"walletBalance":350,"makerFee":0.0002,"takerFee":0.0006,
+					"makerFeeDiscount":0.8,"takerFeeDiscount":0.9}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTieredFeeRateService("key", "secret").WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Level)
+	testutil.AssertDecimalEqual(t, result.DealAmount, "12000.5", "deal amount mismatch")
+	testutil.AssertDecimalEqual(t, result.MakerFee, "0.0002", "maker fee mismatch")
+	testutil.AssertDecimalEqual(t, result.TakerFee, "0.0006", "taker fee mismatch")
+	testutil.AssertDecimalEqual(t, result.TakerFeeDiscount, "0.9", "taker fee discount mismatch")
+}
@@ -0,0 +1,99 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// FundingRate represents the current funding rate of a contract.
+type FundingRate struct {
+	Symbol         string          `json:"symbol"`
+	FundingRate    decimal.Decimal `json:"fundingRate"`
+	MaxFundingRate decimal.Decimal `json:"maxFundingRate"`
+	MinFundingRate decimal.Decimal `json:"minFundingRate"`
+	CollectCycle   int             `json:"collectCycle"`
+	NextSettleTime int64           `json:"nextSettleTime"`
+	Timestamp      int64           `json:"timestamp"`
+}
+
+// FundingRateService gets the funding rate of a symbol.
+type FundingRateService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewFundingRateService creates a new FundingRateService.
+func NewFundingRateService() *FundingRateService {
+	return &FundingRateService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *FundingRateService) WithClient(client transport.HTTPClient) *FundingRateService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the funding rate.
+func (s *FundingRateService) Symbol(symbol string) *FundingRateService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *FundingRateService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("FundingRateService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *FundingRateService) Do(ctx context.Context) (*FundingRate, error) {
+	path := fmt.Sprintf("/api/v1/contract/funding_rate/%s", s.symbol)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "FundingRateService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[FundingRate](resp.Body, op)
+}
+
+func (s *FundingRateService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
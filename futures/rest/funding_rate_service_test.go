@@ -0,0 +1,51 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingRateService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewFundingRateService().Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "FundingRateService.Validate", sdkErr.Op())
+}
+
+func TestFundingRateService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/funding_rate/BTC_USDT")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":{
+					"symbol":"BTC_USDT",
+					"fundingRate":0.0001,
+					"maxFundingRate":0.003,
+					"minFundingRate":-0.003,
+					"collectCycle":8,
+					"nextSettleTime":1659024000000,
+					"timestamp":1658996910762
+				}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewFundingRateService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	testutil.AssertDecimalEqual(t, result.FundingRate, "0.0001", "funding rate mismatch")
+	testutil.AssertDecimalEqual(t, result.MinFundingRate, "-0.003", "min funding rate mismatch")
+	assert.Equal(t, 8, result.CollectCycle)
+	assert.Equal(t, int64(1659024000000), result.NextSettleTime)
+}
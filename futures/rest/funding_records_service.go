@@ -0,0 +1,193 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// FundingRecord represents a funding fee settled on a position.
+type FundingRecord struct {
+	ID            int64
+	Symbol        string
+	PositionType  wsuser.PositionType
+	PositionValue decimal.Decimal
+	Funding       decimal.Decimal
+	Rate          decimal.Decimal
+	SettleTime    int64
+}
+
+type fundingRecordJSON struct {
+	ID            int64           `json:"id"`
+	Symbol        string          `json:"symbol"`
+	PositionType  int             `json:"positionType"`
+	PositionValue decimal.Decimal `json:"positionValue"`
+	Funding       decimal.Decimal `json:"funding"`
+	Rate          decimal.Decimal `json:"rate"`
+	SettleTime    int64           `json:"settleTime"`
+}
+
+func (r *FundingRecord) UnmarshalJSON(data []byte) error {
+	var tmp fundingRecordJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	positionType, err := wsuser.ParsePositionType(tmp.PositionType)
+	if err != nil {
+		return err
+	}
+
+	r.ID = tmp.ID
+	r.Symbol = tmp.Symbol
+	r.PositionType = positionType
+	r.PositionValue = tmp.PositionValue
+	r.Funding = tmp.Funding
+	r.Rate = tmp.Rate
+	r.SettleTime = tmp.SettleTime
+	return nil
+}
+
+// FundingRecords represents a page of funding records.
+type FundingRecords struct {
+	PageSize    int             `json:"pageSize"`
+	TotalCount  int             `json:"totalCount"`
+	TotalPage   int             `json:"totalPage"`
+	CurrentPage int             `json:"currentPage"`
+	ResultList  []FundingRecord `json:"resultList"`
+}
+
+// FundingRecordsService gets the funding fee history of the account.
+type FundingRecordsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+	positionID *int64
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewFundingRecordsService creates a new FundingRecordsService.
+func NewFundingRecordsService(apiKey, secretKey string) *FundingRecordsService {
+	return &FundingRecordsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *FundingRecordsService) WithClient(client transport.HTTPClient) *FundingRecordsService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *FundingRecordsService) Symbol(symbol string) *FundingRecordsService {
+	s.symbol = &symbol
+	return s
+}
+
+// PositionID limits the result to a single position.
+func (s *FundingRecordsService) PositionID(id int64) *FundingRecordsService {
+	s.positionID = &id
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *FundingRecordsService) PageNum(n int) *FundingRecordsService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *FundingRecordsService) PageSize(n int) *FundingRecordsService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *FundingRecordsService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("FundingRecordsService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *FundingRecordsService) Do(ctx context.Context) (*FundingRecords, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/position/funding_records").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "FundingRecordsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[FundingRecords](resp.Body, op)
+}
+
+func (s *FundingRecordsService) validate() error {
+	var errs []string
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *FundingRecordsService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if s.positionID != nil {
+		q.Add("position_id", strconv.FormatInt(*s.positionID, 10))
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFundingRecordsService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewFundingRecordsService("key", "secret").PageNum(0).PageSize(0).Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "FundingRecordsService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "pageNum must be at least 1")
+	assert.Contains(t, sdkErr.Message(), "pageSize must be between 1 and 100")
+}
+
+func TestFundingRecordsService_buildQuery(t *testing.T) {
+	q := NewFundingRecordsService("key", "secret").
+		Symbol("BTC_USDT").PositionID(42).PageNum(1).PageSize(50).buildQuery()
+
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+	assert.Equal(t, "42", q.Get("position_id"))
+	assert.Equal(t, "1", q.Get("page_num"))
+	assert.Equal(t, "50", q.Get("page_size"))
+}
+
+func TestFundingRecordsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/funding_records")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":{
+					"pageSize": 20,
+					"totalCount": 1,
+					"totalPage": 1,
+					"currentPage": 1,
+					"resultList": [{
+						"id": 7,
+						"symbol": "BTC_USDT",
+						"positionType": 2,
+						"positionValue": 3096.5,
+						"funding": -0.3096,
+						"rate": 0.0001,
+						"settleTime": 1609977600000
+					}]
+				}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewFundingRecordsService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, result.TotalCount)
+	require.Len(t, result.ResultList, 1)
+
+	r := result.ResultList[0]
+	assert.Equal(t, int64(7), r.ID)
+	assert.Equal(t, wsuser.PositionTypeShort, r.PositionType)
+	testutil.AssertDecimalEqual(t, r.Funding, "-0.3096", "funding mismatch")
+	assert.Equal(t, int64(1609977600000), r.SettleTime)
+}
@@ -0,0 +1,217 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// HistoryOrdersService gets the order history of the account.
+type HistoryOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+	states     []wsuser.OrderState
+	category   *wsuser.OrderCategory
+	side       *wsuser.OrderSide
+	startTime  *int64
+	endTime    *int64
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewHistoryOrdersService creates a new HistoryOrdersService.
+func NewHistoryOrdersService(apiKey, secretKey string) *HistoryOrdersService {
+	return &HistoryOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *HistoryOrdersService) WithClient(client transport.HTTPClient) *HistoryOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *HistoryOrdersService) Symbol(symbol string) *HistoryOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// States limits the result to orders in the given states.
+func (s *HistoryOrdersService) States(states ...wsuser.OrderState) *HistoryOrdersService {
+	s.states = states
+	return s
+}
+
+// Category limits the result to orders of the given category.
+func (s *HistoryOrdersService) Category(category wsuser.OrderCategory) *HistoryOrdersService {
+	s.category = &category
+	return s
+}
+
+// Side limits the result to orders of the given side.
+func (s *HistoryOrdersService) Side(side wsuser.OrderSide) *HistoryOrdersService {
+	s.side = &side
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *HistoryOrdersService) StartTime(ms int64) *HistoryOrdersService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *HistoryOrdersService) EndTime(ms int64) *HistoryOrdersService {
+	s.endTime = &ms
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *HistoryOrdersService) PageNum(n int) *HistoryOrdersService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *HistoryOrdersService) PageSize(n int) *HistoryOrdersService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *HistoryOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("HistoryOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *HistoryOrdersService) Do(ctx context.Context) ([]Order, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/order/list/history_orders").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "HistoryOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]Order](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *HistoryOrdersService) validate() error {
+	var errs []string
+
+	for _, state := range s.states {
+		if _, ok := orderStateCode(state); !ok {
+			errs = append(errs, "states contains an invalid state")
+			break
+		}
+	}
+
+	if s.category != nil {
+		if _, ok := orderCategoryCode(*s.category); !ok {
+			errs = append(errs, "category is invalid")
+		}
+	}
+
+	if s.side != nil {
+		if _, ok := orderSideCode(*s.side); !ok {
+			errs = append(errs, "side is invalid")
+		}
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *HistoryOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if len(s.states) > 0 {
+		codes := make([]string, 0, len(s.states))
+		for _, state := range s.states {
+			if code, ok := orderStateCode(state); ok {
+				codes = append(codes, strconv.Itoa(code))
+			}
+		}
+		q.Add("states", strings.Join(codes, ","))
+	}
+	if s.category != nil {
+		if code, ok := orderCategoryCode(*s.category); ok {
+			q.Add("category", strconv.Itoa(code))
+		}
+	}
+	if s.side != nil {
+		if code, ok := orderSideCode(*s.side); ok {
+			q.Add("side", strconv.Itoa(code))
+		}
+	}
+	if s.startTime != nil {
+		q.Add("start_time", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("end_time", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,91 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryOrdersService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewHistoryOrdersService("key", "secret").
+			States(wsuser.OrderStateCompleted, wsuser.OrderStateCancelled).
+			Category(wsuser.OrderCategoryLimitOrder).
+			Side(wsuser.OrderSideOpenShort).
+			StartTime(1).EndTime(2).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewHistoryOrdersService("key", "secret").
+			States(wsuser.OrderStateCompleted, wsuser.OrderState("FILLED")).
+			Category(wsuser.OrderCategory("X")).
+			Side(wsuser.OrderSide("BUY")).
+			StartTime(2).EndTime(1).
+			PageSize(0).
+			validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "states contains an invalid state")
+		assert.Contains(t, err.Error(), "category is invalid")
+		assert.Contains(t, err.Error(), "side is invalid")
+		assert.Contains(t, err.Error(), "startTime must not be after endTime")
+		assert.Contains(t, err.Error(), "pageSize must be between 1 and 100")
+	})
+}
+
+func TestHistoryOrdersService_buildQuery(t *testing.T) {
+	q := NewHistoryOrdersService("key", "secret").
+		Symbol("BTC_USDT").
+		States(wsuser.OrderStateCompleted, wsuser.OrderStateCancelled).
+		Category(wsuser.OrderCategoryCloseDelegate).
+		Side(wsuser.OrderSideCloseLong).
+		StartTime(1609992674000).
+		EndTime(1609992694000).
+		PageNum(2).
+		PageSize(50).
+		buildQuery()
+
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+	assert.Equal(t, "3,4", q.Get("states"))
+	assert.Equal(t, "3", q.Get("category"))
+	assert.Equal(t, "4", q.Get("side"))
+	assert.Equal(t, "1609992674000", q.Get("start_time"))
+	assert.Equal(t, "1609992694000", q.Get("end_time"))
+	assert.Equal(t, "2", q.Get("page_num"))
+	assert.Equal(t, "50", q.Get("page_size"))
+}
+
+func TestHistoryOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/list/history_orders")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + orderJSONFixture + `]}`),
+			}, nil
+		},
+	}
+
+	orders, err := NewHistoryOrdersService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, orders, 1)
+}
+
+func TestHistoryOrdersService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewHistoryOrdersService("key", "secret").PageNum(0).Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "HistoryOrdersService.Validate", sdkErr.Op())
+}
@@ -0,0 +1,95 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// IndexPrice represents the index price of a contract.
+type IndexPrice struct {
+	Symbol     string          `json:"symbol"`
+	IndexPrice decimal.Decimal `json:"indexPrice"`
+	Timestamp  int64           `json:"timestamp"`
+}
+
+// IndexPriceService gets the index price of a symbol.
+type IndexPriceService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewIndexPriceService creates a new IndexPriceService.
+func NewIndexPriceService() *IndexPriceService {
+	return &IndexPriceService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *IndexPriceService) WithClient(client transport.HTTPClient) *IndexPriceService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the index price.
+func (s *IndexPriceService) Symbol(symbol string) *IndexPriceService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *IndexPriceService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("IndexPriceService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *IndexPriceService) Do(ctx context.Context) (*IndexPrice, error) {
+	path := fmt.Sprintf("/api/v1/contract/index_price/%s", s.symbol)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "IndexPriceService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[IndexPrice](resp.Body, op)
+}
+
+func (s *IndexPriceService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIndexPriceService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewIndexPriceService().Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "IndexPriceService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+}
+
+func TestIndexPriceService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/index_price/BTC_USDT")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":{"symbol":"BTC_USDT","indexPrice":30971.55,"timestamp":1658996910762}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewIndexPriceService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "BTC_USDT", result.Symbol)
+	testutil.AssertDecimalEqual(t, result.IndexPrice, "30971.55", "index price mismatch")
+	assert.Equal(t, int64(1658996910762), result.Timestamp)
+}
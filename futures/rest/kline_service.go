@@ -0,0 +1,219 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Kline represents a single candlestick. Time is in seconds.
+type Kline struct {
+	Time   int64
+	Open   decimal.Decimal
+	Close  decimal.Decimal
+	High   decimal.Decimal
+	Low    decimal.Decimal
+	Vol    decimal.Decimal
+	Amount decimal.Decimal
+}
+
+// klineColumns is the column-oriented shape the exchange returns klines in.
+type klineColumns struct {
+	Time   []int64           `json:"time"`
+	Open   []decimal.Decimal `json:"open"`
+	Close  []decimal.Decimal `json:"close"`
+	High   []decimal.Decimal `json:"high"`
+	Low    []decimal.Decimal `json:"low"`
+	Vol    []decimal.Decimal `json:"vol"`
+	Amount []decimal.Decimal `json:"amount"`
+}
+
+func (c *klineColumns) rows() ([]Kline, error) {
+	n := len(c.Time)
+	for _, col := range [][]decimal.Decimal{c.Open, c.Close, c.High, c.Low} {
+		if len(col) != n {
+			return nil, errors.New("kline columns have mismatched lengths")
+		}
+	}
+	// vol and amount are absent for index and fair price klines.
+	if (c.Vol != nil && len(c.Vol) != n) || (c.Amount != nil && len(c.Amount) != n) {
+		return nil, errors.New("kline columns have mismatched lengths")
+	}
+
+	klines := make([]Kline, n)
+	for i := range klines {
+		klines[i] = Kline{
+			Time:  c.Time[i],
+			Open:  c.Open[i],
+			Close: c.Close[i],
+			High:  c.High[i],
+			Low:   c.Low[i],
+		}
+		if c.Vol != nil {
+			klines[i].Vol = c.Vol[i]
+		}
+		if c.Amount != nil {
+			klines[i].Amount = c.Amount[i]
+		}
+	}
+	return klines, nil
+}
+
+// KlineService gets the candlesticks of a symbol.
+type KlineService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	basePath   string
+	symbol     string
+	interval   *KlineInterval
+	start      *int64
+	end        *int64
+}
+
+// NewKlineService creates a new KlineService for deal price klines.
+func NewKlineService() *KlineService {
+	return newKlineService("/api/v1/contract/kline")
+}
+
+// NewIndexPriceKlineService creates a new KlineService for index price klines.
+func NewIndexPriceKlineService() *KlineService {
+	return newKlineService("/api/v1/contract/kline/index_price")
+}
+
+// NewFairPriceKlineService creates a new KlineService for fair price klines.
+func NewFairPriceKlineService() *KlineService {
+	return newKlineService("/api/v1/contract/kline/fair_price")
+}
+
+func newKlineService(basePath string) *KlineService {
+	return &KlineService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+		basePath:   basePath,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *KlineService) WithClient(client transport.HTTPClient) *KlineService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the klines.
+func (s *KlineService) Symbol(symbol string) *KlineService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval sets the kline interval. The exchange defaults to Min1.
+func (s *KlineService) Interval(interval KlineInterval) *KlineService {
+	s.interval = &interval
+	return s
+}
+
+// Start sets the start time in seconds.
+func (s *KlineService) Start(sec int64) *KlineService {
+	s.start = &sec
+	return s
+}
+
+// End sets the end time in seconds.
+func (s *KlineService) End(sec int64) *KlineService {
+	s.end = &sec
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *KlineService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("KlineService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *KlineService) Do(ctx context.Context) ([]Kline, error) {
+	path := fmt.Sprintf("%s/%s", s.basePath, s.symbol)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "KlineService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	columns, err := decodeResponse[klineColumns](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+
+	klines, err := columns.rows()
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrDecodeError).
+			WithCause(err)
+	}
+	return klines, nil
+}
+
+func (s *KlineService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.interval != nil && !s.interval.isValid() {
+		errs = append(errs, "interval is invalid")
+	}
+	if s.start != nil && s.end != nil && *s.start > *s.end {
+		errs = append(errs, "start must not be after end")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *KlineService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.interval != nil {
+		q.Add("interval", string(*s.interval))
+	}
+	if s.start != nil {
+		q.Add("start", strconv.FormatInt(*s.start, 10))
+	}
+	if s.end != nil {
+		q.Add("end", strconv.FormatInt(*s.end, 10))
+	}
+	return q
+}
@@ -0,0 +1,119 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKlineService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewKlineService().Symbol("BTC_USDT").Interval(Kline60Min).Start(1).End(2).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewKlineService().Interval(KlineInterval("1h")).Start(2).End(1).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "interval is invalid")
+		assert.Contains(t, err.Error(), "start must not be after end")
+	})
+}
+
+func TestKlineService_buildQuery(t *testing.T) {
+	q := NewKlineService().Symbol("BTC_USDT").Interval(Kline4Hour).Start(1609992674).End(1609992694).buildQuery()
+	assert.Equal(t, "Hour4", q.Get("interval"))
+	assert.Equal(t, "1609992674", q.Get("start"))
+	assert.Equal(t, "1609992694", q.Get("end"))
+}
+
+func TestKlineService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/kline/BTC_USDT")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":{
+					"time":[1609740600,1609741200],
+					"open":[33016.5,33040.5],
+					"close":[33040.5,33020.0],
+					"high":[33094.0,33051.5],
+					"low":[32995.0,33001.0],
+					"vol":[67332.0,48100.0],
+					"amount":[222515.85925,158906.2]
+				}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewKlineService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	k := result[1]
+	assert.Equal(t, int64(1609741200), k.Time)
+	testutil.AssertDecimalEqual(t, k.Open, "33040.5", "open mismatch")
+	testutil.AssertDecimalEqual(t, k.Close, "33020", "close mismatch")
+	testutil.AssertDecimalEqual(t, k.High, "33051.5", "high mismatch")
+	testutil.AssertDecimalEqual(t, k.Low, "33001", "low mismatch")
+	testutil.AssertDecimalEqual(t, k.Vol, "48100", "vol mismatch")
+	testutil.AssertDecimalEqual(t, k.Amount, "158906.2", "amount mismatch")
+}
+
+func TestKlineService_Do_IndexAndFairPricePaths(t *testing.T) {
+	body := []byte(`{"success":true,"code":0,"data":{
+		"time":[1609740600],"open":[1],"close":[2],"high":[3],"low":[0.5]
+	}}`)
+
+	tests := []struct {
+		name string
+		svc  *KlineService
+		path string
+	}{
+		{"index price", NewIndexPriceKlineService(), "/api/v1/contract/kline/index_price/BTC_USDT"},
+		{"fair price", NewFairPriceKlineService(), "/api/v1/contract/kline/fair_price/BTC_USDT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &testutil.FakeHTTPClient{
+				DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+					assert.Contains(t, req.FullURL, tt.path)
+					return &transport.Response{StatusCode: 200, Body: body}, nil
+				},
+			}
+
+			result, err := tt.svc.WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+			require.NoError(t, err)
+			require.Len(t, result, 1)
+			assert.True(t, result[0].Vol.IsZero())
+		})
+	}
+}
+
+func TestKlineService_Do_MismatchedColumns(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"time":[1,2],"open":[1],"close":[1,2],"high":[1,2],"low":[1,2]}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewKlineService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
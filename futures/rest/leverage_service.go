@@ -0,0 +1,303 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Leverage represents the leverage settings of one side of a contract.
+type Leverage struct {
+	PositionType wsuser.PositionType
+	OpenType     wsuser.OpenType
+	Leverage     int
+	Level        int
+	MaxVol       decimal.Decimal
+	Imr          decimal.Decimal
+	Mmr          decimal.Decimal
+}
+
+type leverageJSON struct {
+	PositionType int             `json:"positionType"`
+	OpenType     int             `json:"openType"`
+	Leverage     int             `json:"leverage"`
+	Level        int             `json:"level"`
+	MaxVol       decimal.Decimal `json:"maxVol"`
+	Imr          decimal.Decimal `json:"imr"`
+	Mmr          decimal.Decimal `json:"mmr"`
+}
+
+func (l *Leverage) UnmarshalJSON(data []byte) error {
+	var tmp leverageJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	positionType, err := wsuser.ParsePositionType(tmp.PositionType)
+	if err != nil {
+		return err
+	}
+	openType, err := wsuser.ParseOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+
+	l.PositionType = positionType
+	l.OpenType = openType
+	l.Leverage = tmp.Leverage
+	l.Level = tmp.Level
+	l.MaxVol = tmp.MaxVol
+	l.Imr = tmp.Imr
+	l.Mmr = tmp.Mmr
+	return nil
+}
+
+// LeverageService gets the leverage settings of a contract.
+type LeverageService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewLeverageService creates a new LeverageService.
+func NewLeverageService(apiKey, secretKey string) *LeverageService {
+	return &LeverageService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *LeverageService) WithClient(client transport.HTTPClient) *LeverageService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract.
+func (s *LeverageService) Symbol(symbol string) *LeverageService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *LeverageService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("LeverageService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *LeverageService) Do(ctx context.Context) ([]Leverage, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/position/leverage").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "LeverageService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	leverages, err := decodeResponse[[]Leverage](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *leverages, nil
+}
+
+func (s *LeverageService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
+
+func (s *LeverageService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	return q
+}
+
+// ChangeLeverageService changes the leverage of an existing position, or of
+// a contract side before a position is opened.
+type ChangeLeverageService struct {
+	client       transport.HTTPClient
+	reqBuilder   *requestBuilder
+	leverage     *int
+	positionID   *int64
+	symbol       *string
+	openType     *wsuser.OpenType
+	positionType *wsuser.PositionType
+}
+
+// NewChangeLeverageService creates a new ChangeLeverageService.
+func NewChangeLeverageService(apiKey, secretKey string) *ChangeLeverageService {
+	return &ChangeLeverageService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ChangeLeverageService) WithClient(client transport.HTTPClient) *ChangeLeverageService {
+	s.client = client
+	return s
+}
+
+// Leverage sets the new leverage.
+func (s *ChangeLeverageService) Leverage(leverage int) *ChangeLeverageService {
+	s.leverage = &leverage
+	return s
+}
+
+// PositionID sets the position whose leverage is changed.
+func (s *ChangeLeverageService) PositionID(id int64) *ChangeLeverageService {
+	s.positionID = &id
+	return s
+}
+
+// Symbol sets the contract. Used together with OpenType and PositionType
+// when there is no open position.
+func (s *ChangeLeverageService) Symbol(symbol string) *ChangeLeverageService {
+	s.symbol = &symbol
+	return s
+}
+
+// OpenType sets the margin mode whose leverage is changed.
+func (s *ChangeLeverageService) OpenType(openType wsuser.OpenType) *ChangeLeverageService {
+	s.openType = &openType
+	return s
+}
+
+// PositionType sets the side whose leverage is changed.
+func (s *ChangeLeverageService) PositionType(positionType wsuser.PositionType) *ChangeLeverageService {
+	s.positionType = &positionType
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *ChangeLeverageService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("ChangeLeverageService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *ChangeLeverageService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/position/change_leverage").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "ChangeLeverageService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *ChangeLeverageService) validate() error {
+	var errs []string
+
+	if s.leverage == nil {
+		errs = append(errs, "leverage is required")
+	} else if *s.leverage < 1 {
+		errs = append(errs, "leverage must be at least 1")
+	}
+
+	if s.positionID != nil {
+		if s.symbol != nil || s.openType != nil || s.positionType != nil {
+			errs = append(errs, "positionId cannot be combined with symbol, openType or positionType")
+		}
+	} else {
+		if s.symbol == nil || *s.symbol == "" {
+			errs = append(errs, "symbol is required without positionId")
+		}
+		if s.openType == nil {
+			errs = append(errs, "openType is required without positionId")
+		} else if _, ok := openTypeCode(*s.openType); !ok {
+			errs = append(errs, "openType is invalid")
+		}
+		if s.positionType == nil {
+			errs = append(errs, "positionType is required without positionId")
+		} else if _, ok := positionTypeCode(*s.positionType); !ok {
+			errs = append(errs, "positionType is invalid")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *ChangeLeverageService) buildBody() []byte {
+	params := struct {
+		Leverage     *int    `json:"leverage"`
+		PositionID   *int64  `json:"positionId,omitempty"`
+		Symbol       *string `json:"symbol,omitempty"`
+		OpenType     int     `json:"openType,omitempty"`
+		PositionType int     `json:"positionType,omitempty"`
+	}{
+		Leverage:   s.leverage,
+		PositionID: s.positionID,
+		Symbol:     s.symbol,
+	}
+	if s.openType != nil {
+		params.OpenType, _ = openTypeCode(*s.openType)
+	}
+	if s.positionType != nil {
+		params.PositionType, _ = positionTypeCode(*s.positionType)
+	}
+
+	body, _ := json.Marshal(params)
+	return body
+}
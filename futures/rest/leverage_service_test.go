@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeverageService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewLeverageService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, "LeverageService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+}
+
+func TestLeverageService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/leverage")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTC_USDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[
+					{"positionType":1,"openType":1,"leverage":20,"level":1,"maxVol":1150000,"imr":0.05,"mmr":0.004},
+					{"positionType":2,"openType":1,"leverage":10,"level":1,"maxVol":1150000,"imr":0.1,"mmr":0.004}
+				]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewLeverageService("key", "secret").WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, wsuser.PositionTypeLong, result[0].PositionType)
+	assert.Equal(t, wsuser.OpenTypeIsolated, result[0].OpenType)
+	assert.Equal(t, 20, result[0].Leverage)
+	testutil.AssertDecimalEqual(t, result[0].Imr, "0.05", "imr mismatch")
+	assert.Equal(t, wsuser.PositionTypeShort, result[1].PositionType)
+}
+
+func TestChangeLeverageService_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *ChangeLeverageService
+		wantErr []string
+	}{
+		{
+			name: "by position",
+			svc:  NewChangeLeverageService("key", "secret").Leverage(20).PositionID(1),
+		},
+		{
+			name: "by contract side",
+			svc: NewChangeLeverageService("key", "secret").Leverage(20).
+				Symbol("BTC_USDT").OpenType(wsuser.OpenTypeIsolated).PositionType(wsuser.PositionTypeShort),
+		},
+		{
+			name:    "missing leverage",
+			svc:     NewChangeLeverageService("key", "secret").PositionID(1),
+			wantErr: []string{"leverage is required"},
+		},
+		{
+			name: "position combined with contract side",
+			svc: NewChangeLeverageService("key", "secret").Leverage(5).PositionID(1).
+				Symbol("BTC_USDT"),
+			wantErr: []string{"positionId cannot be combined with symbol, openType or positionType"},
+		},
+		{
+			name: "incomplete contract side",
+			svc:  NewChangeLeverageService("key", "secret").Leverage(0).OpenType("BOTH"),
+			wantErr: []string{
+				"leverage must be at least 1",
+				"symbol is required without positionId",
+				"openType is invalid",
+				"positionType is required without positionId",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.svc.validate()
+			if len(tt.wantErr) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			for _, want := range tt.wantErr {
+				assert.Contains(t, err.Error(), want)
+			}
+		})
+	}
+}
+
+func TestChangeLeverageService_buildBody(t *testing.T) {
+	body := NewChangeLeverageService("key", "secret").Leverage(20).PositionID(1394650).buildBody()
+	assert.JSONEq(t, `{"leverage":20,"positionId":1394650}`, string(body))
+
+	body = NewChangeLeverageService("key", "secret").
+		Leverage(15).
+		Symbol("BTC_USDT").
+		OpenType(wsuser.OpenTypeIsolated).
+		PositionType(wsuser.PositionTypeShort).
+		buildBody()
+	assert.JSONEq(t, `{"leverage":15,"symbol":"BTC_USDT","openType":1,"positionType":2}`, string(body))
+}
+
+func TestChangeLeverageService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/change_leverage")
+
+			_, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewChangeLeverageService("key", "secret").WithClient(fakeClient).Leverage(20).PositionID(1).Do(context.Background())
+	assert.NoError(t, err)
+}
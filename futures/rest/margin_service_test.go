@@ -0,0 +1,50 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChangeMarginService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewChangeMarginService("key", "secret").Amount(decimal.Zero).Type("REMOVE").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "ChangeMarginService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "positionId is required")
+	assert.Contains(t, sdkErr.Message(), "amount must be greater than zero")
+	assert.Contains(t, sdkErr.Message(), "type is invalid")
+}
+
+func TestChangeMarginService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/change_margin")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"positionId":1394650,"amount":12.5,"type":"SUB"}`, string(body))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewChangeMarginService("key", "secret").
+		WithClient(fakeClient).
+		PositionID(1394650).
+		Amount(decimal.RequireFromString("12.5")).
+		Type(MarginChangeReduce).
+		Do(context.Background())
+	assert.NoError(t, err)
+}
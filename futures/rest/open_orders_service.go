@@ -0,0 +1,134 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// OpenOrdersService gets the open orders of the account.
+type OpenOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewOpenOrdersService creates a new OpenOrdersService.
+func NewOpenOrdersService(apiKey, secretKey string) *OpenOrdersService {
+	return &OpenOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *OpenOrdersService) WithClient(client transport.HTTPClient) *OpenOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *OpenOrdersService) Symbol(symbol string) *OpenOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *OpenOrdersService) PageNum(n int) *OpenOrdersService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *OpenOrdersService) PageSize(n int) *OpenOrdersService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *OpenOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("OpenOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *OpenOrdersService) Do(ctx context.Context) ([]Order, error) {
+	path := "/api/v1/private/order/list/open_orders"
+	if s.symbol != nil {
+		path += "/" + *s.symbol
+	}
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "OpenOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]Order](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *OpenOrdersService) validate() error {
+	var errs []string
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *OpenOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
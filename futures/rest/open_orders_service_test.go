@@ -0,0 +1,53 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenOrdersService_validate(t *testing.T) {
+	assert.NoError(t, NewOpenOrdersService("key", "secret").PageNum(1).PageSize(100).validate())
+
+	err := NewOpenOrdersService("key", "secret").PageNum(0).PageSize(101).validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "pageNum must be at least 1")
+	assert.Contains(t, err.Error(), "pageSize must be between 1 and 100")
+}
+
+func TestOpenOrdersService_Do_Success(t *testing.T) {
+	tests := []struct {
+		name string
+		svc  *OpenOrdersService
+		path string
+	}{
+		{"all contracts", NewOpenOrdersService("key", "secret"), "/api/v1/private/order/list/open_orders?"},
+		{"single contract", NewOpenOrdersService("key", "secret").Symbol("BTC_USDT"), "/api/v1/private/order/list/open_orders/BTC_USDT?"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &testutil.FakeHTTPClient{
+				DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Contains(t, req.FullURL, tt.path)
+
+					return &transport.Response{
+						StatusCode: 200,
+						Body:       []byte(`{"success":true,"code":0,"data":[` + orderJSONFixture + `]}`),
+					}, nil
+				},
+			}
+
+			orders, err := tt.svc.WithClient(fakeClient).PageSize(20).Do(context.Background())
+			require.NoError(t, err)
+			require.Len(t, orders, 1)
+			assert.Equal(t, "BTC_USDT", orders[0].Symbol)
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// OrderDeal represents a fill of one of the account's orders.
+type OrderDeal struct {
+	ID          int64
+	Symbol      string
+	Side        wsuser.OrderSide
+	Vol         decimal.Decimal
+	Price       decimal.Decimal
+	Fee         decimal.Decimal
+	FeeCurrency string
+	Profit      decimal.Decimal
+	Category    wsuser.OrderCategory
+	OrderId     string
+	Taker       bool
+	Timestamp   int64
+}
+
+type orderDealJSON struct {
+	ID          int64           `json:"id"`
+	Symbol      string          `json:"symbol"`
+	Side        int             `json:"side"`
+	Vol         decimal.Decimal `json:"vol"`
+	Price       decimal.Decimal `json:"price"`
+	Fee         decimal.Decimal `json:"fee"`
+	FeeCurrency string          `json:"feeCurrency"`
+	Profit      decimal.Decimal `json:"profit"`
+	Category    int             `json:"category"`
+	OrderId     json.Number     `json:"orderId"`
+	Taker       bool            `json:"taker"`
+	Timestamp   int64           `json:"timestamp"`
+}
+
+func (d *OrderDeal) UnmarshalJSON(data []byte) error {
+	var tmp orderDealJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	side, err := wsuser.ParseOrderSide(tmp.Side)
+	if err != nil {
+		return err
+	}
+	category, err := wsuser.ParseOrderCategory(tmp.Category)
+	if err != nil {
+		return err
+	}
+
+	d.ID = tmp.ID
+	d.Symbol = tmp.Symbol
+	d.Side = side
+	d.Vol = tmp.Vol
+	d.Price = tmp.Price
+	d.Fee = tmp.Fee
+	d.FeeCurrency = tmp.FeeCurrency
+	d.Profit = tmp.Profit
+	d.Category = category
+	d.OrderId = tmp.OrderId.String()
+	d.Taker = tmp.Taker
+	d.Timestamp = tmp.Timestamp
+	return nil
+}
+
+// OrderDealsService gets the fills of the account's orders on a contract.
+type OrderDealsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	startTime  *int64
+	endTime    *int64
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewOrderDealsService creates a new OrderDealsService.
+func NewOrderDealsService(apiKey, secretKey string) *OrderDealsService {
+	return &OrderDealsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *OrderDealsService) WithClient(client transport.HTTPClient) *OrderDealsService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract of the fills.
+func (s *OrderDealsService) Symbol(symbol string) *OrderDealsService {
+	s.symbol = symbol
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *OrderDealsService) StartTime(ms int64) *OrderDealsService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *OrderDealsService) EndTime(ms int64) *OrderDealsService {
+	s.endTime = &ms
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *OrderDealsService) PageNum(n int) *OrderDealsService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *OrderDealsService) PageSize(n int) *OrderDealsService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *OrderDealsService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("OrderDealsService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *OrderDealsService) Do(ctx context.Context) ([]OrderDeal, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/order/list/order_deals").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "OrderDealsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	deals, err := decodeResponse[[]OrderDeal](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *deals, nil
+}
+
+func (s *OrderDealsService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *OrderDealsService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.startTime != nil {
+		q.Add("start_time", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("end_time", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrderDealsService_validate(t *testing.T) {
+	assert.NoError(t, NewOrderDealsService("key", "secret").Symbol("BTC_USDT").validate())
+
+	err := NewOrderDealsService("key", "secret").StartTime(5).EndTime(1).PageNum(0).validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "symbol is required")
+	assert.Contains(t, err.Error(), "startTime must not be after endTime")
+	assert.Contains(t, err.Error(), "pageNum must be at least 1")
+}
+
+func TestOrderDealsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/list/order_deals")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTC_USDT", query.Get("symbol"))
+			assert.Equal(t, "10", query.Get("page_size"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[{
+					"id": 1187,
+					"symbol": "BTC_USDT",
+					"side": 3,
+					"vol": 2,
+					"price": 30001,
+					"feeCurrency": "USDT",
+					"fee": 0.024,
+					"timestamp": 1609992674000,
+					"profit": 0,
+					"category": 1,
+					"orderId": 102067003631907840,
+					"taker": true
+				}]}`),
+			}, nil
+		},
+	}
+
+	deals, err := NewOrderDealsService("key", "secret").
+		WithClient(fakeClient).
+		Symbol("BTC_USDT").
+		PageSize(10).
+		Do(context.Background())
+
+	require.NoError(t, err)
+	require.Len(t, deals, 1)
+
+	d := deals[0]
+	assert.Equal(t, int64(1187), d.ID)
+	assert.Equal(t, wsuser.OrderSideOpenShort, d.Side)
+	assert.Equal(t, wsuser.OrderCategoryLimitOrder, d.Category)
+	assert.Equal(t, "102067003631907840", d.OrderId)
+	assert.True(t, d.Taker)
+	testutil.AssertDecimalEqual(t, d.Fee, "0.024", "fee mismatch")
+}
@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// PingService tests connectivity and gets the server time.
+type PingService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewPingService creates a new PingService.
+func NewPingService() *PingService {
+	return &PingService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PingService) WithClient(client transport.HTTPClient) *PingService {
+	s.client = client
+	return s
+}
+
+// Do executes the service and returns the server time in milliseconds.
+func (s *PingService) Do(ctx context.Context) (int64, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/contract/ping").
+		Build()
+
+	op := "PingService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return 0, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return 0, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	serverTime, err := decodeResponse[int64](resp.Body, op)
+	if err != nil {
+		return 0, err
+	}
+	return *serverTime, nil
+}
@@ -0,0 +1,61 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/ping")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":1587442022003}`),
+			}, nil
+		},
+	}
+
+	serverTime, err := NewPingService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1587442022003), serverTime)
+}
+
+func TestPingService_Do_Errors(t *testing.T) {
+	t.Run("request failed", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				return nil, errors.New("network is down")
+			},
+		}
+
+		_, err := NewPingService().WithClient(fakeClient).Do(context.Background())
+
+		var sdkErr *sdkerr.SDKError
+		assert.ErrorAs(t, err, &sdkErr)
+		assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+	})
+
+	t.Run("decode error", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				return &transport.Response{StatusCode: 200, Body: []byte(`{"data":"oops"}`)}, nil
+			},
+		}
+
+		_, err := NewPingService().WithClient(fakeClient).Do(context.Background())
+
+		var sdkErr *sdkerr.SDKError
+		assert.ErrorAs(t, err, &sdkErr)
+		assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+	})
+}
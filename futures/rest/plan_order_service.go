@@ -0,0 +1,534 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// PlanOrder represents a trigger order that places a regular order once its
+// trigger price is reached.
+type PlanOrder struct {
+	ID           string
+	Symbol       string
+	Leverage     int
+	Side         wsuser.OrderSide
+	TriggerPrice decimal.Decimal
+	Price        decimal.Decimal
+	Vol          decimal.Decimal
+	OpenType     wsuser.OpenType
+	TriggerType  TriggerType
+	State        PlanOrderState
+	ExecuteCycle ExecuteCycle
+	Trend        TriggerPriceSource
+	OrderType    wsuser.OrderType
+	ErrorCode    int
+	OrderId      string
+	CreateTime   int64
+	UpdateTime   int64
+}
+
+type planOrderJSON struct {
+	ID           json.Number     `json:"id"`
+	Symbol       string          `json:"symbol"`
+	Leverage     int             `json:"leverage"`
+	Side         int             `json:"side"`
+	TriggerPrice decimal.Decimal `json:"triggerPrice"`
+	Price        decimal.Decimal `json:"price"`
+	Vol          decimal.Decimal `json:"vol"`
+	OpenType     int             `json:"openType"`
+	TriggerType  int             `json:"triggerType"`
+	State        int             `json:"state"`
+	ExecuteCycle int             `json:"executeCycle"`
+	Trend        int             `json:"trend"`
+	OrderType    int             `json:"orderType"`
+	ErrorCode    int             `json:"errorCode"`
+	OrderId      json.Number     `json:"orderId"`
+	CreateTime   int64           `json:"createTime"`
+	UpdateTime   int64           `json:"updateTime"`
+}
+
+func (p *PlanOrder) UnmarshalJSON(data []byte) error {
+	var tmp planOrderJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	side, err := wsuser.ParseOrderSide(tmp.Side)
+	if err != nil {
+		return err
+	}
+	openType, err := wsuser.ParseOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+	triggerType, err := parseTriggerType(tmp.TriggerType)
+	if err != nil {
+		return err
+	}
+	state, err := parsePlanOrderState(tmp.State)
+	if err != nil {
+		return err
+	}
+	executeCycle, err := parseExecuteCycle(tmp.ExecuteCycle)
+	if err != nil {
+		return err
+	}
+	trend, err := parseTriggerPriceSource(tmp.Trend)
+	if err != nil {
+		return err
+	}
+	orderType, err := wsuser.ParseOrderType(tmp.OrderType)
+	if err != nil {
+		return err
+	}
+
+	p.ID = tmp.ID.String()
+	p.Symbol = tmp.Symbol
+	p.Leverage = tmp.Leverage
+	p.Side = side
+	p.TriggerPrice = tmp.TriggerPrice
+	p.Price = tmp.Price
+	p.Vol = tmp.Vol
+	p.OpenType = openType
+	p.TriggerType = triggerType
+	p.State = state
+	p.ExecuteCycle = executeCycle
+	p.Trend = trend
+	p.OrderType = orderType
+	p.ErrorCode = tmp.ErrorCode
+	p.OrderId = tmp.OrderId.String()
+	p.CreateTime = tmp.CreateTime
+	p.UpdateTime = tmp.UpdateTime
+	return nil
+}
+
+// PlacePlanOrderService places a trigger order.
+type PlacePlanOrderService struct {
+	client       transport.HTTPClient
+	reqBuilder   *requestBuilder
+	symbol       string
+	price        *decimal.Decimal
+	vol          *decimal.Decimal
+	leverage     *int
+	side         wsuser.OrderSide
+	openType     wsuser.OpenType
+	triggerPrice *decimal.Decimal
+	triggerType  TriggerType
+	executeCycle ExecuteCycle
+	orderType    wsuser.OrderType
+	trend        TriggerPriceSource
+}
+
+// NewPlacePlanOrderService creates a new PlacePlanOrderService.
+func NewPlacePlanOrderService(apiKey, secretKey string) *PlacePlanOrderService {
+	return &PlacePlanOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PlacePlanOrderService) WithClient(client transport.HTTPClient) *PlacePlanOrderService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract of the order.
+func (s *PlacePlanOrderService) Symbol(symbol string) *PlacePlanOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// Price sets the price of the order placed on trigger. Required for limit orders.
+func (s *PlacePlanOrderService) Price(price decimal.Decimal) *PlacePlanOrderService {
+	s.price = &price
+	return s
+}
+
+// Vol sets the volume of the order in contracts.
+func (s *PlacePlanOrderService) Vol(vol decimal.Decimal) *PlacePlanOrderService {
+	s.vol = &vol
+	return s
+}
+
+// Leverage sets the leverage of the order. Required when opening an isolated position.
+func (s *PlacePlanOrderService) Leverage(leverage int) *PlacePlanOrderService {
+	s.leverage = &leverage
+	return s
+}
+
+// Side sets the side of the order.
+func (s *PlacePlanOrderService) Side(side wsuser.OrderSide) *PlacePlanOrderService {
+	s.side = side
+	return s
+}
+
+// OpenType sets the margin mode of the order.
+func (s *PlacePlanOrderService) OpenType(openType wsuser.OpenType) *PlacePlanOrderService {
+	s.openType = openType
+	return s
+}
+
+// TriggerPrice sets the price at which the order is placed.
+func (s *PlacePlanOrderService) TriggerPrice(price decimal.Decimal) *PlacePlanOrderService {
+	s.triggerPrice = &price
+	return s
+}
+
+// TriggerType sets how the trigger price is compared with the market.
+func (s *PlacePlanOrderService) TriggerType(triggerType TriggerType) *PlacePlanOrderService {
+	s.triggerType = triggerType
+	return s
+}
+
+// ExecuteCycle sets how long the plan order stays active.
+func (s *PlacePlanOrderService) ExecuteCycle(cycle ExecuteCycle) *PlacePlanOrderService {
+	s.executeCycle = cycle
+	return s
+}
+
+// OrderType sets the type of the order placed on trigger, limit or market.
+func (s *PlacePlanOrderService) OrderType(orderType wsuser.OrderType) *PlacePlanOrderService {
+	s.orderType = orderType
+	return s
+}
+
+// Trend sets the price source the trigger is compared against.
+func (s *PlacePlanOrderService) Trend(source TriggerPriceSource) *PlacePlanOrderService {
+	s.trend = source
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *PlacePlanOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("PlacePlanOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service and returns the ID of the plan order.
+func (s *PlacePlanOrderService) Do(ctx context.Context) (string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/planorder/place").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "PlacePlanOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	id, err := decodeResponse[json.Number](resp.Body, op)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (s *PlacePlanOrderService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if _, ok := orderSideCode(s.side); !ok {
+		errs = append(errs, "side is invalid")
+	}
+
+	if _, ok := openTypeCode(s.openType); !ok {
+		errs = append(errs, "openType is invalid")
+	}
+
+	if _, ok := s.triggerType.code(); !ok {
+		errs = append(errs, "triggerType is invalid")
+	}
+
+	if _, ok := s.executeCycle.code(); !ok {
+		errs = append(errs, "executeCycle is invalid")
+	}
+
+	if _, ok := s.trend.code(); !ok {
+		errs = append(errs, "trend is invalid")
+	}
+
+	if s.orderType != wsuser.OrderTypeLimit && s.orderType != wsuser.OrderTypeMarket {
+		errs = append(errs, "orderType must be LIMIT or MARKET")
+	}
+
+	if s.vol == nil {
+		errs = append(errs, "vol is required")
+	} else if s.vol.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "vol must be greater than zero")
+	}
+
+	if s.triggerPrice == nil {
+		errs = append(errs, "triggerPrice is required")
+	} else if s.triggerPrice.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "triggerPrice must be greater than zero")
+	}
+
+	if s.price == nil {
+		if s.orderType == wsuser.OrderTypeLimit {
+			errs = append(errs, "price is required for limit orders")
+		}
+	} else if s.price.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "price must be greater than zero")
+	}
+
+	if s.leverage != nil && *s.leverage < 1 {
+		errs = append(errs, "leverage must be at least 1")
+	}
+
+	if s.openType == wsuser.OpenTypeIsolated && isOpenSide(s.side) && s.leverage == nil {
+		errs = append(errs, "leverage is required when opening an isolated position")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+type planOrderParams struct {
+	Symbol       string      `json:"symbol"`
+	Price        json.Number `json:"price,omitempty"`
+	Vol          json.Number `json:"vol"`
+	Leverage     *int        `json:"leverage,omitempty"`
+	Side         int         `json:"side"`
+	OpenType     int         `json:"openType"`
+	TriggerPrice json.Number `json:"triggerPrice"`
+	TriggerType  int         `json:"triggerType"`
+	ExecuteCycle int         `json:"executeCycle"`
+	OrderType    int         `json:"orderType"`
+	Trend        int         `json:"trend"`
+}
+
+func (s *PlacePlanOrderService) buildBody() []byte {
+	side, _ := orderSideCode(s.side)
+	openType, _ := openTypeCode(s.openType)
+	triggerType, _ := s.triggerType.code()
+	executeCycle, _ := s.executeCycle.code()
+	orderType, _ := orderTypeCode(s.orderType)
+	trend, _ := s.trend.code()
+
+	p := planOrderParams{
+		Symbol:       s.symbol,
+		Leverage:     s.leverage,
+		Side:         side,
+		OpenType:     openType,
+		TriggerType:  triggerType,
+		ExecuteCycle: executeCycle,
+		OrderType:    orderType,
+		Trend:        trend,
+	}
+	if s.price != nil {
+		p.Price = json.Number(s.price.String())
+	}
+	if s.vol != nil {
+		p.Vol = json.Number(s.vol.String())
+	}
+	if s.triggerPrice != nil {
+		p.TriggerPrice = json.Number(s.triggerPrice.String())
+	}
+
+	// Marshalling cannot fail: planOrderParams holds only plain values.
+	body, _ := json.Marshal(p)
+	return body
+}
+
+// PlanOrdersService lists the plan orders of the account.
+type PlanOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+	states     []PlanOrderState
+	startTime  *int64
+	endTime    *int64
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewPlanOrdersService creates a new PlanOrdersService.
+func NewPlanOrdersService(apiKey, secretKey string) *PlanOrdersService {
+	return &PlanOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PlanOrdersService) WithClient(client transport.HTTPClient) *PlanOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *PlanOrdersService) Symbol(symbol string) *PlanOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// States limits the result to plan orders in the given states.
+func (s *PlanOrdersService) States(states ...PlanOrderState) *PlanOrdersService {
+	s.states = states
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *PlanOrdersService) StartTime(ms int64) *PlanOrdersService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *PlanOrdersService) EndTime(ms int64) *PlanOrdersService {
+	s.endTime = &ms
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *PlanOrdersService) PageNum(n int) *PlanOrdersService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *PlanOrdersService) PageSize(n int) *PlanOrdersService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *PlanOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("PlanOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *PlanOrdersService) Do(ctx context.Context) ([]PlanOrder, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/planorder/list/orders").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "PlanOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]PlanOrder](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *PlanOrdersService) validate() error {
+	var errs []string
+
+	for _, state := range s.states {
+		if _, ok := state.code(); !ok {
+			errs = append(errs, "states contains an invalid state")
+			break
+		}
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *PlanOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if len(s.states) > 0 {
+		codes := make([]string, 0, len(s.states))
+		for _, state := range s.states {
+			if code, ok := state.code(); ok {
+				codes = append(codes, strconv.Itoa(code))
+			}
+		}
+		q.Add("states", strings.Join(codes, ","))
+	}
+	if s.startTime != nil {
+		q.Add("start_time", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("end_time", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,177 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPlanOrder() *PlacePlanOrderService {
+	return NewPlacePlanOrderService("key", "secret").
+		Symbol("BTC_USDT").
+		Vol(decimal.NewFromInt(2)).
+		Side(wsuser.OrderSideOpenLong).
+		OpenType(wsuser.OpenTypeCross).
+		TriggerPrice(decimal.NewFromInt(31000)).
+		TriggerType(TriggerTypeGreaterOrEqual).
+		ExecuteCycle(ExecuteCycle7Days).
+		OrderType(wsuser.OrderTypeMarket).
+		Trend(TriggerPriceSourceFair)
+}
+
+func TestPlacePlanOrderService_validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     *PlacePlanOrderService
+		wantErr string
+	}{
+		{"valid market trigger", newTestPlanOrder(), ""},
+		{"valid limit trigger", newTestPlanOrder().OrderType(wsuser.OrderTypeLimit).Price(decimal.NewFromInt(31010)), ""},
+		{"limit without price", newTestPlanOrder().OrderType(wsuser.OrderTypeLimit), "price is required for limit orders"},
+		{"unsupported order type", newTestPlanOrder().OrderType(wsuser.OrderTypeFillOrKill), "orderType must be LIMIT or MARKET"},
+		{"invalid trigger type", newTestPlanOrder().TriggerType("EQ"), "triggerType is invalid"},
+		{"invalid execute cycle", newTestPlanOrder().ExecuteCycle("30_DAYS"), "executeCycle is invalid"},
+		{"invalid trend", newTestPlanOrder().Trend("MARK"), "trend is invalid"},
+		{"non-positive trigger price", newTestPlanOrder().TriggerPrice(decimal.Zero), "triggerPrice must be greater than zero"},
+		{"isolated open without leverage", newTestPlanOrder().OpenType(wsuser.OpenTypeIsolated), "leverage is required when opening an isolated position"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.svc.validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestPlacePlanOrderService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewPlacePlanOrderService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "PlacePlanOrderService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+	assert.Contains(t, sdkErr.Message(), "triggerPrice is required")
+}
+
+func TestPlacePlanOrderService_buildBody(t *testing.T) {
+	body := newTestPlanOrder().buildBody()
+
+	assert.JSONEq(t, `{
+		"symbol": "BTC_USDT",
+		"vol": 2,
+		"side": 1,
+		"openType": 2,
+		"triggerPrice": 31000,
+		"triggerType": 1,
+		"executeCycle": 2,
+		"orderType": 5,
+		"trend": 2
+	}`, string(body))
+}
+
+func TestPlacePlanOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/planorder/place")
+			assert.Equal(t, "key", req.Headers.Get("ApiKey"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":"12345"}`),
+			}, nil
+		},
+	}
+
+	id, err := newTestPlanOrder().WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "12345", id)
+}
+
+func TestPlanOrdersService_buildQuery(t *testing.T) {
+	q := NewPlanOrdersService("key", "secret").
+		Symbol("BTC_USDT").
+		States(PlanOrderStateUntriggered, PlanOrderStateExecutionFailed).
+		StartTime(1).
+		EndTime(2).
+		PageNum(1).
+		PageSize(20).
+		buildQuery()
+
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+	assert.Equal(t, "1,5", q.Get("states"))
+	assert.Equal(t, "1", q.Get("start_time"))
+	assert.Equal(t, "2", q.Get("end_time"))
+	assert.Equal(t, "1", q.Get("page_num"))
+	assert.Equal(t, "20", q.Get("page_size"))
+}
+
+func TestPlanOrdersService_validate(t *testing.T) {
+	err := NewPlanOrdersService("key", "secret").States("DONE").StartTime(2).EndTime(1).PageSize(101).validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "states contains an invalid state")
+	assert.Contains(t, err.Error(), "startTime must not be after endTime")
+	assert.Contains(t, err.Error(), "pageSize must be between 1 and 100")
+}
+
+func TestPlanOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/planorder/list/orders")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[{
+					"id": "12345",
+					"symbol": "BTC_USDT",
+					"leverage": 20,
+					"side": 3,
+					"triggerPrice": 29000,
+					"price": 28990,
+					"vol": 1,
+					"openType": 1,
+					"triggerType": 2,
+					"state": 1,
+					"executeCycle": 1,
+					"trend": 3,
+					"orderType": 1,
+					"errorCode": 0,
+					"orderId": 0,
+					"createTime": 1609992674000,
+					"updateTime": 1609992674000
+				}]}`),
+			}, nil
+		},
+	}
+
+	orders, err := NewPlanOrdersService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	o := orders[0]
+	assert.Equal(t, "12345", o.ID)
+	assert.Equal(t, wsuser.OrderSideOpenShort, o.Side)
+	assert.Equal(t, wsuser.OpenTypeIsolated, o.OpenType)
+	assert.Equal(t, TriggerTypeLessOrEqual, o.TriggerType)
+	assert.Equal(t, PlanOrderStateUntriggered, o.State)
+	assert.Equal(t, ExecuteCycle24Hours, o.ExecuteCycle)
+	assert.Equal(t, TriggerPriceSourceIndex, o.Trend)
+	assert.Equal(t, wsuser.OrderTypeLimit, o.OrderType)
+	testutil.AssertDecimalEqual(t, o.TriggerPrice, "29000", "trigger price mismatch")
+}
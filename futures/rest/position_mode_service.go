@@ -0,0 +1,170 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// positionModeCode returns the REST code of mode. Position modes reuse the
+// futures/wsuser enum so that a change made here can be matched against the
+// PositionModeSub push.
+func positionModeCode(mode wsuser.PositionMode) (int, bool) {
+	switch mode {
+	case wsuser.PositionModeHedge:
+		return 1, true
+	case wsuser.PositionModeOneWay:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+// PositionModeService gets the position mode of the account.
+type PositionModeService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewPositionModeService creates a new PositionModeService.
+func NewPositionModeService(apiKey, secretKey string) *PositionModeService {
+	return &PositionModeService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PositionModeService) WithClient(client transport.HTTPClient) *PositionModeService {
+	s.client = client
+	return s
+}
+
+// Do executes the service.
+func (s *PositionModeService) Do(ctx context.Context) (wsuser.PositionMode, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/position/position_mode").
+		Build()
+
+	op := "PositionModeService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	code, err := decodeResponse[int](resp.Body, op)
+	if err != nil {
+		return "", err
+	}
+
+	mode, err := wsuser.ParsePositionMode(*code)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrDecodeError).
+			WithCause(err)
+	}
+	return mode, nil
+}
+
+// ChangePositionModeService switches the account between hedge and one-way mode.
+// The exchange rejects the change while positions or orders are open.
+type ChangePositionModeService struct {
+	client       transport.HTTPClient
+	reqBuilder   *requestBuilder
+	positionMode wsuser.PositionMode
+}
+
+// NewChangePositionModeService creates a new ChangePositionModeService.
+func NewChangePositionModeService(apiKey, secretKey string) *ChangePositionModeService {
+	return &ChangePositionModeService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ChangePositionModeService) WithClient(client transport.HTTPClient) *ChangePositionModeService {
+	s.client = client
+	return s
+}
+
+// PositionMode sets the new position mode.
+func (s *ChangePositionModeService) PositionMode(mode wsuser.PositionMode) *ChangePositionModeService {
+	s.positionMode = mode
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *ChangePositionModeService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("ChangePositionModeService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *ChangePositionModeService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/position/change_position_mode").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "ChangePositionModeService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *ChangePositionModeService) validate() error {
+	if _, ok := positionModeCode(s.positionMode); !ok {
+		return errors.New("positionMode is invalid")
+	}
+	return nil
+}
+
+func (s *ChangePositionModeService) buildBody() []byte {
+	code, _ := positionModeCode(s.positionMode)
+	body, _ := json.Marshal(map[string]int{"positionMode": code})
+	return body
+}
@@ -0,0 +1,87 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPositionModeService_Do(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want wsuser.PositionMode
+	}{
+		{"hedge", `{"success":true,"code":0,"data":1}`, wsuser.PositionModeHedge},
+		{"one-way", `{"success":true,"code":0,"data":2}`, wsuser.PositionModeOneWay},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &testutil.FakeHTTPClient{
+				DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+					assert.Equal(t, http.MethodGet, req.Method)
+					assert.Contains(t, req.FullURL, "/api/v1/private/position/position_mode")
+					return &transport.Response{StatusCode: 200, Body: []byte(tt.body)}, nil
+				},
+			}
+
+			mode, err := NewPositionModeService("key", "secret").WithClient(fakeClient).Do(context.Background())
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, mode)
+		})
+	}
+}
+
+func TestPositionModeService_Do_UnknownMode(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"data":3}`)}, nil
+		},
+	}
+
+	_, err := NewPositionModeService("key", "secret").WithClient(fakeClient).Do(context.Background())
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
+
+func TestChangePositionModeService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewChangePositionModeService("key", "secret").PositionMode("BOTH").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "ChangePositionModeService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "positionMode is invalid")
+}
+
+func TestChangePositionModeService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/change_position_mode")
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"positionMode":2}`, string(body))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewChangePositionModeService("key", "secret").
+		WithClient(fakeClient).
+		PositionMode(wsuser.PositionModeOneWay).
+		Do(context.Background())
+	assert.NoError(t, err)
+}
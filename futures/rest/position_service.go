@@ -0,0 +1,315 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Position represents a futures position. Its shape mirrors the position
+// event pushed by futures/wsuser.
+type Position struct {
+	PositionId     int64
+	Symbol         string
+	HoldVol        decimal.Decimal
+	PositionType   wsuser.PositionType
+	OpenType       wsuser.OpenType
+	State          wsuser.PositionState
+	FrozenVol      decimal.Decimal
+	CloseVol       decimal.Decimal
+	HoldAvgPrice   decimal.Decimal
+	CloseAvgPrice  decimal.Decimal
+	OpenAvgPrice   decimal.Decimal
+	LiquidatePrice decimal.Decimal
+	Oim            decimal.Decimal
+	AdlLevel       int
+	Im             decimal.Decimal
+	HoldFee        decimal.Decimal
+	Realised       decimal.Decimal
+	AutoAddIm      bool
+	Leverage       int
+	CreateTime     int64
+	UpdateTime     int64
+}
+
+type positionJSON struct {
+	PositionId     int64           `json:"positionId"`
+	Symbol         string          `json:"symbol"`
+	HoldVol        decimal.Decimal `json:"holdVol"`
+	PositionType   int             `json:"positionType"`
+	OpenType       int             `json:"openType"`
+	State          int             `json:"state"`
+	FrozenVol      decimal.Decimal `json:"frozenVol"`
+	CloseVol       decimal.Decimal `json:"closeVol"`
+	HoldAvgPrice   decimal.Decimal `json:"holdAvgPrice"`
+	CloseAvgPrice  decimal.Decimal `json:"closeAvgPrice"`
+	OpenAvgPrice   decimal.Decimal `json:"openAvgPrice"`
+	LiquidatePrice decimal.Decimal `json:"liquidatePrice"`
+	Oim            decimal.Decimal `json:"oim"`
+	AdlLevel       int             `json:"adlLevel"`
+	Im             decimal.Decimal `json:"im"`
+	HoldFee        decimal.Decimal `json:"holdFee"`
+	Realised       decimal.Decimal `json:"realised"`
+	AutoAddIm      bool            `json:"autoAddIm"`
+	Leverage       int             `json:"leverage"`
+	CreateTime     int64           `json:"createTime"`
+	UpdateTime     int64           `json:"updateTime"`
+}
+
+func (p *Position) UnmarshalJSON(data []byte) error {
+	var tmp positionJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	positionType, err := wsuser.ParsePositionType(tmp.PositionType)
+	if err != nil {
+		return err
+	}
+	openType, err := wsuser.ParseOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+	state, err := wsuser.ParsePositionState(tmp.State)
+	if err != nil {
+		return err
+	}
+
+	p.PositionId = tmp.PositionId
+	p.Symbol = tmp.Symbol
+	p.HoldVol = tmp.HoldVol
+	p.PositionType = positionType
+	p.OpenType = openType
+	p.State = state
+	p.FrozenVol = tmp.FrozenVol
+	p.CloseVol = tmp.CloseVol
+	p.HoldAvgPrice = tmp.HoldAvgPrice
+	p.CloseAvgPrice = tmp.CloseAvgPrice
+	p.OpenAvgPrice = tmp.OpenAvgPrice
+	p.LiquidatePrice = tmp.LiquidatePrice
+	p.Oim = tmp.Oim
+	p.AdlLevel = tmp.AdlLevel
+	p.Im = tmp.Im
+	p.HoldFee = tmp.HoldFee
+	p.Realised = tmp.Realised
+	p.AutoAddIm = tmp.AutoAddIm
+	p.Leverage = tmp.Leverage
+	p.CreateTime = tmp.CreateTime
+	p.UpdateTime = tmp.UpdateTime
+	return nil
+}
+
+// OpenPositionsService gets the currently held positions.
+type OpenPositionsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewOpenPositionsService creates a new OpenPositionsService.
+func NewOpenPositionsService(apiKey, secretKey string) *OpenPositionsService {
+	return &OpenPositionsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *OpenPositionsService) WithClient(client transport.HTTPClient) *OpenPositionsService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *OpenPositionsService) Symbol(symbol string) *OpenPositionsService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *OpenPositionsService) Do(ctx context.Context) ([]Position, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/position/open_positions").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "OpenPositionsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	positions, err := decodeResponse[[]Position](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *positions, nil
+}
+
+func (s *OpenPositionsService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	return q
+}
+
+// HistoryPositionsService gets the closed positions of the account.
+type HistoryPositionsService struct {
+	client       transport.HTTPClient
+	reqBuilder   *requestBuilder
+	symbol       *string
+	positionType *wsuser.PositionType
+	pageNum      *int
+	pageSize     *int
+}
+
+// NewHistoryPositionsService creates a new HistoryPositionsService.
+func NewHistoryPositionsService(apiKey, secretKey string) *HistoryPositionsService {
+	return &HistoryPositionsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *HistoryPositionsService) WithClient(client transport.HTTPClient) *HistoryPositionsService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *HistoryPositionsService) Symbol(symbol string) *HistoryPositionsService {
+	s.symbol = &symbol
+	return s
+}
+
+// PositionType limits the result to long or short positions.
+func (s *HistoryPositionsService) PositionType(positionType wsuser.PositionType) *HistoryPositionsService {
+	s.positionType = &positionType
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *HistoryPositionsService) PageNum(n int) *HistoryPositionsService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *HistoryPositionsService) PageSize(n int) *HistoryPositionsService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *HistoryPositionsService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("HistoryPositionsService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *HistoryPositionsService) Do(ctx context.Context) ([]Position, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/position/list/history_positions").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "HistoryPositionsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	positions, err := decodeResponse[[]Position](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *positions, nil
+}
+
+func (s *HistoryPositionsService) validate() error {
+	var errs []string
+
+	if s.positionType != nil {
+		if _, ok := positionTypeCode(*s.positionType); !ok {
+			errs = append(errs, "positionType is invalid")
+		}
+	}
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *HistoryPositionsService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if s.positionType != nil {
+		if code, ok := positionTypeCode(*s.positionType); ok {
+			q.Add("type", strconv.Itoa(code))
+		}
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,137 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const positionJSONFixture = `{
+	"positionId": 1394650,
+	"symbol": "ETH_USDT",
+	"positionType": 1,
+	"openType": 1,
+	"state": 1,
+	"holdVol": 1,
+	"frozenVol": 0,
+	"closeVol": 0,
+	"holdAvgPrice": 1217.3,
+	"openAvgPrice": 1217.3,
+	"closeAvgPrice": 0,
+	"liquidatePrice": 1211.2,
+	"oim": 0.1290338,
+	"im": 0.1290338,
+	"holdFee": 0,
+	"realised": -0.0073,
+	"leverage": 100,
+	"createTime": 1609991676000,
+	"updateTime": 1609991676000,
+	"autoAddIm": false,
+	"adlLevel": 2
+}`
+
+func TestOpenPositionsService_buildQuery(t *testing.T) {
+	q := NewOpenPositionsService("key", "secret").buildQuery()
+	assert.False(t, q.Has("symbol"))
+
+	q = NewOpenPositionsService("key", "secret").Symbol("ETH_USDT").buildQuery()
+	assert.Equal(t, "ETH_USDT", q.Get("symbol"))
+}
+
+func TestOpenPositionsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/open_positions")
+			assert.Equal(t, "key", req.Headers.Get("ApiKey"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + positionJSONFixture + `]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewOpenPositionsService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	p := result[0]
+	assert.Equal(t, int64(1394650), p.PositionId)
+	assert.Equal(t, wsuser.PositionTypeLong, p.PositionType)
+	assert.Equal(t, wsuser.OpenTypeIsolated, p.OpenType)
+	assert.Equal(t, wsuser.PositionStateHolding, p.State)
+	assert.Equal(t, 100, p.Leverage)
+	assert.Equal(t, 2, p.AdlLevel)
+	assert.Equal(t, int64(1609991676000), p.CreateTime)
+	testutil.AssertDecimalEqual(t, p.HoldAvgPrice, "1217.3", "hold avg price mismatch")
+	testutil.AssertDecimalEqual(t, p.Realised, "-0.0073", "realised mismatch")
+}
+
+func TestOpenPositionsService_Do_InvalidCode(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":[{"positionId":1,"positionType":3,"openType":1,"state":1}]}`),
+			}, nil
+		},
+	}
+
+	_, err := NewOpenPositionsService("key", "secret").WithClient(fakeClient).Do(context.Background())
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
+
+func TestHistoryPositionsService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewHistoryPositionsService("key", "secret").
+			PositionType(wsuser.PositionTypeShort).PageNum(1).PageSize(100).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewHistoryPositionsService("key", "secret").
+			PositionType(wsuser.PositionType("BOTH")).PageNum(0).PageSize(101).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "positionType is invalid")
+		assert.Contains(t, err.Error(), "pageNum must be at least 1")
+		assert.Contains(t, err.Error(), "pageSize must be between 1 and 100")
+	})
+}
+
+func TestHistoryPositionsService_buildQuery(t *testing.T) {
+	q := NewHistoryPositionsService("key", "secret").
+		Symbol("ETH_USDT").PositionType(wsuser.PositionTypeShort).PageNum(2).PageSize(20).buildQuery()
+
+	assert.Equal(t, "ETH_USDT", q.Get("symbol"))
+	assert.Equal(t, "2", q.Get("type"))
+	assert.Equal(t, "2", q.Get("page_num"))
+	assert.Equal(t, "20", q.Get("page_size"))
+}
+
+func TestHistoryPositionsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Contains(t, req.FullURL, "/api/v1/private/position/list/history_positions")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + positionJSONFixture + `]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewHistoryPositionsService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+}
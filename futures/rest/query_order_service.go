@@ -0,0 +1,205 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Order represents a futures order. Its shape mirrors the order event pushed
+// by futures/wsuser.
+type Order struct {
+	OrderId      string
+	Symbol       string
+	PositionId   int64
+	Price        decimal.Decimal
+	Vol          decimal.Decimal
+	Leverage     decimal.Decimal
+	Side         wsuser.OrderSide
+	Category     wsuser.OrderCategory
+	OrderType    wsuser.OrderType
+	DealAvgPrice decimal.Decimal
+	DealVol      decimal.Decimal
+	OrderMargin  decimal.Decimal
+	UsedMargin   decimal.Decimal
+	TakerFee     decimal.Decimal
+	MakerFee     decimal.Decimal
+	Profit       decimal.Decimal
+	FeeCurrency  string
+	OpenType     wsuser.OpenType
+	State        wsuser.OrderState
+	ErrorCode    wsuser.OrderErrorCode
+	ExternalOid  string
+	CreateTime   int64
+	UpdateTime   int64
+}
+
+type orderJSON struct {
+	OrderId      json.Number     `json:"orderId"`
+	Symbol       string          `json:"symbol"`
+	PositionId   int64           `json:"positionId"`
+	Price        decimal.Decimal `json:"price"`
+	Vol          decimal.Decimal `json:"vol"`
+	Leverage     decimal.Decimal `json:"leverage"`
+	Side         int             `json:"side"`
+	Category     int             `json:"category"`
+	OrderType    int             `json:"orderType"`
+	DealAvgPrice decimal.Decimal `json:"dealAvgPrice"`
+	DealVol      decimal.Decimal `json:"dealVol"`
+	OrderMargin  decimal.Decimal `json:"orderMargin"`
+	UsedMargin   decimal.Decimal `json:"usedMargin"`
+	TakerFee     decimal.Decimal `json:"takerFee"`
+	MakerFee     decimal.Decimal `json:"makerFee"`
+	Profit       decimal.Decimal `json:"profit"`
+	FeeCurrency  string          `json:"feeCurrency"`
+	OpenType     int             `json:"openType"`
+	State        int             `json:"state"`
+	ErrorCode    int             `json:"errorCode"`
+	ExternalOid  string          `json:"externalOid"`
+	CreateTime   int64           `json:"createTime"`
+	UpdateTime   int64           `json:"updateTime"`
+}
+
+func (o *Order) UnmarshalJSON(data []byte) error {
+	var tmp orderJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	side, err := wsuser.ParseOrderSide(tmp.Side)
+	if err != nil {
+		return err
+	}
+	category, err := wsuser.ParseOrderCategory(tmp.Category)
+	if err != nil {
+		return err
+	}
+	orderType, err := wsuser.ParseOrderType(tmp.OrderType)
+	if err != nil {
+		return err
+	}
+	openType, err := wsuser.ParseOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+	state, err := wsuser.ParseOrderState(tmp.State)
+	if err != nil {
+		return err
+	}
+	errorCode, err := wsuser.ParseOrderErrorCode(tmp.ErrorCode)
+	if err != nil {
+		return err
+	}
+
+	o.OrderId = tmp.OrderId.String()
+	o.Symbol = tmp.Symbol
+	o.PositionId = tmp.PositionId
+	o.Price = tmp.Price
+	o.Vol = tmp.Vol
+	o.Leverage = tmp.Leverage
+	o.Side = side
+	o.Category = category
+	o.OrderType = orderType
+	o.DealAvgPrice = tmp.DealAvgPrice
+	o.DealVol = tmp.DealVol
+	o.OrderMargin = tmp.OrderMargin
+	o.UsedMargin = tmp.UsedMargin
+	o.TakerFee = tmp.TakerFee
+	o.MakerFee = tmp.MakerFee
+	o.Profit = tmp.Profit
+	o.FeeCurrency = tmp.FeeCurrency
+	o.OpenType = openType
+	o.State = state
+	o.ErrorCode = errorCode
+	o.ExternalOid = tmp.ExternalOid
+	o.CreateTime = tmp.CreateTime
+	o.UpdateTime = tmp.UpdateTime
+	return nil
+}
+
+// QueryOrderService gets a single order by its ID.
+type QueryOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	orderID    string
+}
+
+// NewQueryOrderService creates a new QueryOrderService.
+func NewQueryOrderService(apiKey, secretKey string) *QueryOrderService {
+	return &QueryOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *QueryOrderService) WithClient(client transport.HTTPClient) *QueryOrderService {
+	s.client = client
+	return s
+}
+
+// OrderID sets the ID of the order.
+func (s *QueryOrderService) OrderID(id string) *QueryOrderService {
+	s.orderID = id
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *QueryOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("QueryOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *QueryOrderService) Do(ctx context.Context) (*Order, error) {
+	path := fmt.Sprintf("/api/v1/private/order/get/%s", s.orderID)
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath(path).
+		Build()
+
+	op := "QueryOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[Order](resp.Body, op)
+}
+
+func (s *QueryOrderService) validate() error {
+	if s.orderID == "" {
+		return errors.New("orderId is required")
+	}
+	if _, err := strconv.ParseInt(s.orderID, 10, 64); err != nil {
+		return errors.New("orderId must be numeric")
+	}
+	return nil
+}
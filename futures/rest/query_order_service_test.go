@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderJSONFixture = `{
+	"orderId": "102067003631907840",
+	"symbol": "BTC_USDT",
+	"positionId": 1394650,
+	"price": 30000.5,
+	"vol": 2,
+	"leverage": 20,
+	"side": 1,
+	"category": 1,
+	"orderType": 1,
+	"dealAvgPrice": 30000.5,
+	"dealVol": 1,
+	"orderMargin": 3.0001,
+	"usedMargin": 1.5,
+	"takerFee": 0.012,
+	"makerFee": 0,
+	"profit": 0,
+	"feeCurrency": "USDT",
+	"openType": 1,
+	"state": 2,
+	"errorCode": 0,
+	"externalOid": "my-order",
+	"createTime": 1609992674000,
+	"updateTime": 1609992675000
+}`
+
+func TestQueryOrderService_validate(t *testing.T) {
+	assert.NoError(t, NewQueryOrderService("key", "secret").OrderID("102067003631907840").validate())
+
+	err := NewQueryOrderService("key", "secret").validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "orderId is required")
+
+	err = NewQueryOrderService("key", "secret").OrderID("../assets").validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "orderId must be numeric")
+}
+
+func TestQueryOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/get/102067003631907840")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":` + orderJSONFixture + `}`),
+			}, nil
+		},
+	}
+
+	order, err := NewQueryOrderService("key", "secret").
+		WithClient(fakeClient).
+		OrderID("102067003631907840").
+		Do(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "102067003631907840", order.OrderId)
+	assert.Equal(t, wsuser.OrderSideOpenLong, order.Side)
+	assert.Equal(t, wsuser.OrderCategoryLimitOrder, order.Category)
+	assert.Equal(t, wsuser.OrderTypeLimit, order.OrderType)
+	assert.Equal(t, wsuser.OpenTypeIsolated, order.OpenType)
+	assert.Equal(t, wsuser.OrderStateUncompleted, order.State)
+	assert.Equal(t, wsuser.OrderErrorCodeNormal, order.ErrorCode)
+	assert.Equal(t, "my-order", order.ExternalOid)
+	testutil.AssertDecimalEqual(t, order.Price, "30000.5", "price mismatch")
+	testutil.AssertDecimalEqual(t, order.Leverage, "20", "leverage mismatch")
+	testutil.AssertDecimalEqual(t, order.TakerFee, "0.012", "taker fee mismatch")
+}
+
+func TestQueryOrderService_Do_NumericOrderID(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"data":{"orderId":102067003631907840,"side":4,"category":3,
+					"orderType":5,"openType":2,"state":3,"errorCode":0}}`),
+			}, nil
+		},
+	}
+
+	order, err := NewQueryOrderService("key", "secret").WithClient(fakeClient).OrderID("1").Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "102067003631907840", order.OrderId)
+	assert.Equal(t, wsuser.OrderSideCloseLong, order.Side)
+	assert.Equal(t, wsuser.OrderTypeMarket, order.OrderType)
+	assert.Equal(t, wsuser.OpenTypeCross, order.OpenType)
+}
+
+func TestQueryOrderService_Do_InvalidState(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"orderId":"1","side":1,"category":1,"orderType":1,"openType":1,"state":9}}`),
+			}, nil
+		},
+	}
+
+	_, err := NewQueryOrderService("key", "secret").WithClient(fakeClient).OrderID("1").Do(context.Background())
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
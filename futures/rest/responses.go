@@ -4,35 +4,90 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
 	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
-	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
 )
 
-type responseErr struct {
-	Code int `json:"code"`
+// responseEnvelope is the wrapper the contract API puts around every
+// response. Failures are usually reported with HTTP 200 and success=false.
+type responseEnvelope struct {
+	Success *bool  `json:"success"`
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+func (e *responseEnvelope) failed() bool {
+	return e.Success != nil && !*e.Success
+}
+
+func (e *responseEnvelope) err() error {
+	return &errs.APIError{
+		Code:    errs.ErrorCode(e.Code),
+		Message: e.Message,
+	}
 }
 
 func checkResponseError(status int, body []byte) error {
+	var env responseEnvelope
 	if status >= 200 && status < 300 {
+		if err := json.Unmarshal(body, &env); err != nil {
+			return nil
+		}
+		if env.failed() {
+			return env.err()
+		}
 		return nil
 	}
-	var respErr responseErr
-	if err := json.Unmarshal(body, &respErr); err != nil {
+	if err := json.Unmarshal(body, &env); err != nil {
 		return fmt.Errorf("http status %d: %s", status, string(body))
 	}
-	return errs.ErrorCode(respErr.Code)
+	return env.err()
 }
 
 func decodeResponse[T any](data []byte, op string) (*T, error) {
 	var result struct {
+		responseEnvelope
 		Data T `json:"data"`
 	}
 	if err := json.Unmarshal(data, &result); err != nil {
+		var env responseEnvelope
+		if json.Unmarshal(data, &env) == nil && env.failed() {
+			return nil, apiError(op, env.err())
+		}
 		return nil, sdkerr.NewSDKError().
 			WithSubsys(subsys).
 			WithOp(op).
 			WithKind(sdkerr.ErrDecodeError).
 			WithCause(err)
 	}
+	if result.failed() {
+		return nil, apiError(op, result.err())
+	}
 	return &result.Data, nil
 }
+
+func apiError(op string, err error) error {
+	return sdkerr.NewSDKError().
+		WithSubsys(subsys).
+		WithOp(op).
+		WithKind(sdkerr.ErrAPIError).
+		WithCause(err)
+}
+
+// decodeOneOrMany decodes endpoints whose data is a single object when a
+// symbol is given and an array otherwise.
+func decodeOneOrMany[T any](data []byte, single bool, op string) ([]T, error) {
+	if single {
+		item, err := decodeResponse[T](data, op)
+		if err != nil {
+			return nil, err
+		}
+		return []T{*item}, nil
+	}
+
+	items, err := decodeResponse[[]T](data, op)
+	if err != nil {
+		return nil, err
+	}
+	return *items, nil
+}
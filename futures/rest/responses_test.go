@@ -1,10 +1,14 @@
 package rest
 
 import (
+	"errors"
 	"net/http"
 	"testing"
 
+	"github.com/IvanTurko/mexc-sdk-go/futures/errs"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestCheckResponseError_OK(t *testing.T) {
@@ -13,10 +17,27 @@ func TestCheckResponseError_OK(t *testing.T) {
 }
 
 func TestCheckResponseError_KnownErrorCode_ReturnsExpectedMessage(t *testing.T) {
-	body := []byte(`{"code": -2011, "msg": "Unknown order sent"}`)
+	body := []byte(`{"success": false, "code": 2005, "message": "balance not enough"}`)
 	err := checkResponseError(400, body)
 	assert.Error(t, err)
-	assert.Contains(t, err.Error(), "Unknown order sent")
+	assert.Contains(t, err.Error(), "Balance insufficient")
+	assert.Contains(t, err.Error(), "balance not enough")
+	assert.True(t, errors.Is(err, errs.ErrBalanceInsufficient))
+}
+
+func TestCheckResponseError_SuccessFalseWithHTTP200(t *testing.T) {
+	body := []byte(`{"success": false, "code": 2005, "message": "balance not enough"}`)
+	err := checkResponseError(http.StatusOK, body)
+
+	var apiErr *errs.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, errs.ErrBalanceInsufficient, apiErr.Code)
+	assert.Equal(t, "balance not enough", apiErr.Message)
+}
+
+func TestCheckResponseError_SuccessTrueWithHTTP200(t *testing.T) {
+	err := checkResponseError(http.StatusOK, []byte(`{"success": true, "code": 0, "data": 1}`))
+	assert.NoError(t, err)
 }
 
 func TestCheckResponseError_UnknownErrorCode_ReturnsGenericMessage(t *testing.T) {
@@ -47,9 +68,48 @@ func TestDecodeResponse_Success(t *testing.T) {
 	assert.Equal(t, "test", result.Name)
 }
 
+func TestDecodeResponse_SuccessFalse(t *testing.T) {
+	data := []byte(`{"success": false, "code": 2005, "message": "balance not enough"}`)
+	_, err := decodeResponse[int](data, "TestOp")
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+	assert.True(t, errors.Is(err, errs.ErrBalanceInsufficient))
+}
+
+func TestDecodeResponse_SuccessFalseWithMismatchedData(t *testing.T) {
+	data := []byte(`{"success": false, "code": 1001, "data": "contract not exists"}`)
+	_, err := decodeResponse[[]int](data, "TestOp")
+	assert.True(t, errors.Is(err, errs.ErrContractDoesNotExist))
+}
+
 func TestDecodeResponse_InvalidJSON(t *testing.T) {
 	data := []byte(`{"name":`) // malformed
 	_, err := decodeResponse[struct{ Name string }](data, "DecodeFail")
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "DecodeFail")
 }
+
+func TestDecodeOneOrMany(t *testing.T) {
+	type Item struct {
+		Symbol string `json:"symbol"`
+	}
+
+	t.Run("single object", func(t *testing.T) {
+		items, err := decodeOneOrMany[Item]([]byte(`{"data":{"symbol":"BTC_USDT"}}`), true, "TestOp")
+		assert.NoError(t, err)
+		assert.Equal(t, []Item{{Symbol: "BTC_USDT"}}, items)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		items, err := decodeOneOrMany[Item]([]byte(`{"data":[{"symbol":"BTC_USDT"},{"symbol":"ETH_USDT"}]}`), false, "TestOp")
+		assert.NoError(t, err)
+		assert.Len(t, items, 2)
+	})
+
+	t.Run("shape mismatch", func(t *testing.T) {
+		_, err := decodeOneOrMany[Item]([]byte(`{"data":[{"symbol":"BTC_USDT"}]}`), true, "TestOp")
+		assert.Error(t, err)
+	})
+}
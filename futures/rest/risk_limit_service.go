@@ -0,0 +1,265 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// RiskLimit represents the current risk-limit tier of one side of a contract.
+// Symbol, PositionType and Level identify the tier the same way as
+// futures/wsuser.RiskLimitEvent.
+type RiskLimit struct {
+	Symbol       string
+	PositionType wsuser.PositionType
+	OpenType     wsuser.OpenType
+	Level        int
+	MaxVol       decimal.Decimal
+	MaxLeverage  int
+	Mmr          decimal.Decimal
+	Imr          decimal.Decimal
+	Leverage     int
+	LimitBySys   bool
+	CurrentMmr   decimal.Decimal
+}
+
+type riskLimitJSON struct {
+	Symbol       string          `json:"symbol"`
+	PositionType int             `json:"positionType"`
+	OpenType     int             `json:"openType"`
+	Level        int             `json:"level"`
+	MaxVol       decimal.Decimal `json:"maxVol"`
+	MaxLeverage  int             `json:"maxLeverage"`
+	Mmr          decimal.Decimal `json:"mmr"`
+	Imr          decimal.Decimal `json:"imr"`
+	Leverage     int             `json:"leverage"`
+	LimitBySys   bool            `json:"limitBySys"`
+	CurrentMmr   decimal.Decimal `json:"currentMmr"`
+}
+
+func (r *RiskLimit) UnmarshalJSON(data []byte) error {
+	var tmp riskLimitJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	positionType, err := wsuser.ParsePositionType(tmp.PositionType)
+	if err != nil {
+		return err
+	}
+	openType, err := wsuser.ParseOpenType(tmp.OpenType)
+	if err != nil {
+		return err
+	}
+
+	r.Symbol = tmp.Symbol
+	r.PositionType = positionType
+	r.OpenType = openType
+	r.Level = tmp.Level
+	r.MaxVol = tmp.MaxVol
+	r.MaxLeverage = tmp.MaxLeverage
+	r.Mmr = tmp.Mmr
+	r.Imr = tmp.Imr
+	r.Leverage = tmp.Leverage
+	r.LimitBySys = tmp.LimitBySys
+	r.CurrentMmr = tmp.CurrentMmr
+	return nil
+}
+
+// RiskLimitService gets the risk-limit tiers in effect for the account,
+// keyed by contract.
+type RiskLimitService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewRiskLimitService creates a new RiskLimitService.
+func NewRiskLimitService(apiKey, secretKey string) *RiskLimitService {
+	return &RiskLimitService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *RiskLimitService) WithClient(client transport.HTTPClient) *RiskLimitService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *RiskLimitService) Symbol(symbol string) *RiskLimitService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *RiskLimitService) Do(ctx context.Context) (map[string][]RiskLimit, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/account/risk_limit").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "RiskLimitService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	limits, err := decodeResponse[map[string][]RiskLimit](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *limits, nil
+}
+
+func (s *RiskLimitService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	return q
+}
+
+// ChangeRiskLevelService moves one side of a contract to another risk-limit tier.
+type ChangeRiskLevelService struct {
+	client       transport.HTTPClient
+	reqBuilder   *requestBuilder
+	symbol       string
+	level        *int
+	positionType wsuser.PositionType
+}
+
+// NewChangeRiskLevelService creates a new ChangeRiskLevelService.
+func NewChangeRiskLevelService(apiKey, secretKey string) *ChangeRiskLevelService {
+	return &ChangeRiskLevelService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ChangeRiskLevelService) WithClient(client transport.HTTPClient) *ChangeRiskLevelService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract.
+func (s *ChangeRiskLevelService) Symbol(symbol string) *ChangeRiskLevelService {
+	s.symbol = symbol
+	return s
+}
+
+// Level sets the target risk-limit tier.
+func (s *ChangeRiskLevelService) Level(level int) *ChangeRiskLevelService {
+	s.level = &level
+	return s
+}
+
+// PositionType sets the side whose tier is changed.
+func (s *ChangeRiskLevelService) PositionType(positionType wsuser.PositionType) *ChangeRiskLevelService {
+	s.positionType = positionType
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *ChangeRiskLevelService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("ChangeRiskLevelService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *ChangeRiskLevelService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/account/change_risk_level").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "ChangeRiskLevelService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *ChangeRiskLevelService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.level == nil {
+		errs = append(errs, "level is required")
+	} else if *s.level < 1 {
+		errs = append(errs, "level must be at least 1")
+	}
+
+	if _, ok := positionTypeCode(s.positionType); !ok {
+		errs = append(errs, "positionType is invalid")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *ChangeRiskLevelService) buildBody() []byte {
+	params := struct {
+		Symbol       string `json:"symbol"`
+		Level        *int   `json:"level"`
+		PositionType int    `json:"positionType"`
+	}{
+		Symbol: s.symbol,
+		Level:  s.level,
+	}
+	params.PositionType, _ = positionTypeCode(s.positionType)
+
+	body, _ := json.Marshal(params)
+	return body
+}
@@ -0,0 +1,112 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRiskLimitService_buildQuery(t *testing.T) {
+	q := NewRiskLimitService("key", "secret").buildQuery()
+	assert.False(t, q.Has("symbol"))
+
+	q = NewRiskLimitService("key", "secret").Symbol("BTC_USDT").buildQuery()
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+}
+
+func TestRiskLimitService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/account/risk_limit")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":{"BTC_USDT":[
+					{"symbol":"BTC_USDT","positionType":1,"openType":1,"level":1,"maxVol":1150000,
+					 "maxLeverage":125,"mmr":0.004,"imr":0.008,"leverage":20,"limitBySys":false,"currentMmr":0.004},
+					{"symbol":"BTC_USDT","positionType":2,"openType":2,"level":2,"maxVol":2300000,
+					 "maxLeverage":100,"mmr":0.006,"imr":0.01,"leverage":10,"limitBySys":true,"currentMmr":0.006}
+				]}}`),
+			}, nil
+		},
+	}
+
+	result, err := NewRiskLimitService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result["BTC_USDT"], 2)
+
+	l := result["BTC_USDT"][0]
+	assert.Equal(t, "BTC_USDT", l.Symbol)
+	assert.Equal(t, wsuser.PositionTypeLong, l.PositionType)
+	assert.Equal(t, wsuser.OpenTypeIsolated, l.OpenType)
+	assert.Equal(t, 1, l.Level)
+	assert.Equal(t, 125, l.MaxLeverage)
+	testutil.AssertDecimalEqual(t, l.Mmr, "0.004", "mmr mismatch")
+	testutil.AssertDecimalEqual(t, l.MaxVol, "1150000", "max vol mismatch")
+
+	l = result["BTC_USDT"][1]
+	assert.Equal(t, wsuser.PositionTypeShort, l.PositionType)
+	assert.Equal(t, wsuser.OpenTypeCross, l.OpenType)
+	assert.True(t, l.LimitBySys)
+}
+
+func TestRiskLimitService_Do_InvalidCode(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"BTC_USDT":[{"positionType":3,"openType":1}]}}`),
+			}, nil
+		},
+	}
+
+	_, err := NewRiskLimitService("key", "secret").WithClient(fakeClient).Do(context.Background())
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
+
+func TestChangeRiskLevelService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewChangeRiskLevelService("key", "secret").
+			Symbol("BTC_USDT").Level(2).PositionType(wsuser.PositionTypeLong).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewChangeRiskLevelService("key", "secret").Level(0).validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "level must be at least 1")
+		assert.Contains(t, err.Error(), "positionType is invalid")
+	})
+}
+
+func TestChangeRiskLevelService_buildBody(t *testing.T) {
+	body := NewChangeRiskLevelService("key", "secret").
+		Symbol("BTC_USDT").Level(2).PositionType(wsuser.PositionTypeShort).buildBody()
+	assert.JSONEq(t, `{"symbol":"BTC_USDT","level":2,"positionType":2}`, string(body))
+}
+
+func TestChangeRiskLevelService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/account/change_risk_level")
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+		},
+	}
+
+	err := NewChangeRiskLevelService("key", "secret").WithClient(fakeClient).
+		Symbol("BTC_USDT").Level(2).PositionType(wsuser.PositionTypeLong).Do(context.Background())
+	assert.NoError(t, err)
+}
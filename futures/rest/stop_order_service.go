@@ -0,0 +1,570 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// StopOrder represents a take-profit/stop-loss order attached to a position.
+type StopOrder struct {
+	ID              string
+	OrderId         string
+	Symbol          string
+	PositionId      int64
+	StopLossPrice   decimal.Decimal
+	TakeProfitPrice decimal.Decimal
+	State           PlanOrderState
+	TriggerSide     StopTriggerSide
+	PositionType    wsuser.PositionType
+	Vol             decimal.Decimal
+	RealityVol      decimal.Decimal
+	PlaceOrderId    string
+	ErrorCode       int
+	IsFinished      bool
+	CreateTime      int64
+	UpdateTime      int64
+}
+
+type stopOrderJSON struct {
+	ID              json.Number     `json:"id"`
+	OrderId         json.Number     `json:"orderId"`
+	Symbol          string          `json:"symbol"`
+	PositionId      int64           `json:"positionId"`
+	StopLossPrice   decimal.Decimal `json:"stopLossPrice"`
+	TakeProfitPrice decimal.Decimal `json:"takeProfitPrice"`
+	State           int             `json:"state"`
+	TriggerSide     int             `json:"triggerSide"`
+	PositionType    int             `json:"positionType"`
+	Vol             decimal.Decimal `json:"vol"`
+	RealityVol      decimal.Decimal `json:"realityVol"`
+	PlaceOrderId    json.Number     `json:"placeOrderId"`
+	ErrorCode       int             `json:"errorCode"`
+	IsFinished      int             `json:"isFinished"`
+	CreateTime      int64           `json:"createTime"`
+	UpdateTime      int64           `json:"updateTime"`
+}
+
+func (o *StopOrder) UnmarshalJSON(data []byte) error {
+	var tmp stopOrderJSON
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+
+	state, err := parsePlanOrderState(tmp.State)
+	if err != nil {
+		return err
+	}
+	triggerSide, err := parseStopTriggerSide(tmp.TriggerSide)
+	if err != nil {
+		return err
+	}
+	positionType, err := wsuser.ParsePositionType(tmp.PositionType)
+	if err != nil {
+		return err
+	}
+
+	o.ID = tmp.ID.String()
+	o.OrderId = tmp.OrderId.String()
+	o.Symbol = tmp.Symbol
+	o.PositionId = tmp.PositionId
+	o.StopLossPrice = tmp.StopLossPrice
+	o.TakeProfitPrice = tmp.TakeProfitPrice
+	o.State = state
+	o.TriggerSide = triggerSide
+	o.PositionType = positionType
+	o.Vol = tmp.Vol
+	o.RealityVol = tmp.RealityVol
+	o.PlaceOrderId = tmp.PlaceOrderId.String()
+	o.ErrorCode = tmp.ErrorCode
+	o.IsFinished = tmp.IsFinished == 1
+	o.CreateTime = tmp.CreateTime
+	o.UpdateTime = tmp.UpdateTime
+	return nil
+}
+
+// stopPrices holds the take-profit/stop-loss legs shared by placing and
+// modifying stop orders.
+type stopPrices struct {
+	stopLossPrice   *decimal.Decimal
+	takeProfitPrice *decimal.Decimal
+	lossTrend       *TriggerPriceSource
+	profitTrend     *TriggerPriceSource
+}
+
+func (p *stopPrices) validate() []string {
+	var errs []string
+
+	if p.stopLossPrice == nil && p.takeProfitPrice == nil {
+		errs = append(errs, "stopLossPrice or takeProfitPrice is required")
+	}
+
+	if p.stopLossPrice != nil && p.stopLossPrice.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "stopLossPrice must be greater than zero")
+	}
+
+	if p.takeProfitPrice != nil && p.takeProfitPrice.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "takeProfitPrice must be greater than zero")
+	}
+
+	if p.lossTrend != nil {
+		if _, ok := p.lossTrend.code(); !ok {
+			errs = append(errs, "lossTrend is invalid")
+		}
+	}
+
+	if p.profitTrend != nil {
+		if _, ok := p.profitTrend.code(); !ok {
+			errs = append(errs, "profitTrend is invalid")
+		}
+	}
+
+	return errs
+}
+
+type stopOrderParams struct {
+	PositionID      *int64      `json:"positionId,omitempty"`
+	StopPlanOrderID *int64      `json:"stopPlanOrderId,omitempty"`
+	Vol             json.Number `json:"vol,omitempty"`
+	StopLossPrice   json.Number `json:"stopLossPrice,omitempty"`
+	TakeProfitPrice json.Number `json:"takeProfitPrice,omitempty"`
+	LossTrend       int         `json:"lossTrend,omitempty"`
+	ProfitTrend     int         `json:"profitTrend,omitempty"`
+}
+
+func (p *stopPrices) params() stopOrderParams {
+	var sp stopOrderParams
+	if p.stopLossPrice != nil {
+		sp.StopLossPrice = json.Number(p.stopLossPrice.String())
+	}
+	if p.takeProfitPrice != nil {
+		sp.TakeProfitPrice = json.Number(p.takeProfitPrice.String())
+	}
+	if p.lossTrend != nil {
+		sp.LossTrend, _ = p.lossTrend.code()
+	}
+	if p.profitTrend != nil {
+		sp.ProfitTrend, _ = p.profitTrend.code()
+	}
+	return sp
+}
+
+// PlaceStopOrderService attaches a take-profit/stop-loss order to an open position.
+type PlaceStopOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	positionID *int64
+	vol        *decimal.Decimal
+	prices     stopPrices
+}
+
+// NewPlaceStopOrderService creates a new PlaceStopOrderService.
+func NewPlaceStopOrderService(apiKey, secretKey string) *PlaceStopOrderService {
+	return &PlaceStopOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PlaceStopOrderService) WithClient(client transport.HTTPClient) *PlaceStopOrderService {
+	s.client = client
+	return s
+}
+
+// PositionID sets the position the stop order protects.
+func (s *PlaceStopOrderService) PositionID(id int64) *PlaceStopOrderService {
+	s.positionID = &id
+	return s
+}
+
+// Vol sets the volume closed when the stop order fires.
+func (s *PlaceStopOrderService) Vol(vol decimal.Decimal) *PlaceStopOrderService {
+	s.vol = &vol
+	return s
+}
+
+// StopLossPrice sets the stop-loss trigger price.
+func (s *PlaceStopOrderService) StopLossPrice(price decimal.Decimal) *PlaceStopOrderService {
+	s.prices.stopLossPrice = &price
+	return s
+}
+
+// TakeProfitPrice sets the take-profit trigger price.
+func (s *PlaceStopOrderService) TakeProfitPrice(price decimal.Decimal) *PlaceStopOrderService {
+	s.prices.takeProfitPrice = &price
+	return s
+}
+
+// LossTrend sets the price source of the stop-loss trigger.
+func (s *PlaceStopOrderService) LossTrend(source TriggerPriceSource) *PlaceStopOrderService {
+	s.prices.lossTrend = &source
+	return s
+}
+
+// ProfitTrend sets the price source of the take-profit trigger.
+func (s *PlaceStopOrderService) ProfitTrend(source TriggerPriceSource) *PlaceStopOrderService {
+	s.prices.profitTrend = &source
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *PlaceStopOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("PlaceStopOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service and returns the ID of the stop order.
+func (s *PlaceStopOrderService) Do(ctx context.Context) (string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/stoporder/place").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "PlaceStopOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	id, err := decodeResponse[json.Number](resp.Body, op)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (s *PlaceStopOrderService) validate() error {
+	var errs []string
+
+	if s.positionID == nil {
+		errs = append(errs, "positionId is required")
+	}
+
+	if s.vol == nil {
+		errs = append(errs, "vol is required")
+	} else if s.vol.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "vol must be greater than zero")
+	}
+
+	errs = append(errs, s.prices.validate()...)
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *PlaceStopOrderService) buildBody() []byte {
+	p := s.prices.params()
+	p.PositionID = s.positionID
+	if s.vol != nil {
+		p.Vol = json.Number(s.vol.String())
+	}
+
+	// Marshalling cannot fail: stopOrderParams holds only plain values.
+	body, _ := json.Marshal(p)
+	return body
+}
+
+// ChangeStopOrderPriceService modifies the trigger prices of a stop order.
+type ChangeStopOrderPriceService struct {
+	client          transport.HTTPClient
+	reqBuilder      *requestBuilder
+	stopPlanOrderID *int64
+	prices          stopPrices
+}
+
+// NewChangeStopOrderPriceService creates a new ChangeStopOrderPriceService.
+func NewChangeStopOrderPriceService(apiKey, secretKey string) *ChangeStopOrderPriceService {
+	return &ChangeStopOrderPriceService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ChangeStopOrderPriceService) WithClient(client transport.HTTPClient) *ChangeStopOrderPriceService {
+	s.client = client
+	return s
+}
+
+// StopPlanOrderID sets the ID of the stop order to modify.
+func (s *ChangeStopOrderPriceService) StopPlanOrderID(id int64) *ChangeStopOrderPriceService {
+	s.stopPlanOrderID = &id
+	return s
+}
+
+// StopLossPrice sets the new stop-loss trigger price.
+func (s *ChangeStopOrderPriceService) StopLossPrice(price decimal.Decimal) *ChangeStopOrderPriceService {
+	s.prices.stopLossPrice = &price
+	return s
+}
+
+// TakeProfitPrice sets the new take-profit trigger price.
+func (s *ChangeStopOrderPriceService) TakeProfitPrice(price decimal.Decimal) *ChangeStopOrderPriceService {
+	s.prices.takeProfitPrice = &price
+	return s
+}
+
+// LossTrend sets the price source of the stop-loss trigger.
+func (s *ChangeStopOrderPriceService) LossTrend(source TriggerPriceSource) *ChangeStopOrderPriceService {
+	s.prices.lossTrend = &source
+	return s
+}
+
+// ProfitTrend sets the price source of the take-profit trigger.
+func (s *ChangeStopOrderPriceService) ProfitTrend(source TriggerPriceSource) *ChangeStopOrderPriceService {
+	s.prices.profitTrend = &source
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *ChangeStopOrderPriceService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("ChangeStopOrderPriceService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *ChangeStopOrderPriceService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/stoporder/change_plan_price").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "ChangeStopOrderPriceService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
+
+func (s *ChangeStopOrderPriceService) validate() error {
+	var errs []string
+
+	if s.stopPlanOrderID == nil {
+		errs = append(errs, "stopPlanOrderId is required")
+	}
+
+	errs = append(errs, s.prices.validate()...)
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *ChangeStopOrderPriceService) buildBody() []byte {
+	p := s.prices.params()
+	p.StopPlanOrderID = s.stopPlanOrderID
+
+	body, _ := json.Marshal(p)
+	return body
+}
+
+// StopOrdersService lists the stop orders of the account.
+type StopOrdersService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+	isFinished *bool
+	startTime  *int64
+	endTime    *int64
+	pageNum    *int
+	pageSize   *int
+}
+
+// NewStopOrdersService creates a new StopOrdersService.
+func NewStopOrdersService(apiKey, secretKey string) *StopOrdersService {
+	return &StopOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *StopOrdersService) WithClient(client transport.HTTPClient) *StopOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *StopOrdersService) Symbol(symbol string) *StopOrdersService {
+	s.symbol = &symbol
+	return s
+}
+
+// IsFinished limits the result to active or finished stop orders.
+func (s *StopOrdersService) IsFinished(finished bool) *StopOrdersService {
+	s.isFinished = &finished
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *StopOrdersService) StartTime(ms int64) *StopOrdersService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *StopOrdersService) EndTime(ms int64) *StopOrdersService {
+	s.endTime = &ms
+	return s
+}
+
+// PageNum sets the page number, starting at 1.
+func (s *StopOrdersService) PageNum(n int) *StopOrdersService {
+	s.pageNum = &n
+	return s
+}
+
+// PageSize sets the number of records per page.
+func (s *StopOrdersService) PageSize(n int) *StopOrdersService {
+	s.pageSize = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *StopOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("StopOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *StopOrdersService) Do(ctx context.Context) ([]StopOrder, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/private/stoporder/list/orders").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "StopOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]StopOrder](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *StopOrdersService) validate() error {
+	var errs []string
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.pageNum != nil && *s.pageNum < 1 {
+		errs = append(errs, "pageNum must be at least 1")
+	}
+
+	if s.pageSize != nil && (*s.pageSize < 1 || *s.pageSize > 100) {
+		errs = append(errs, "pageSize must be between 1 and 100")
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *StopOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if s.isFinished != nil {
+		if *s.isFinished {
+			q.Add("is_finished", "1")
+		} else {
+			q.Add("is_finished", "0")
+		}
+	}
+	if s.startTime != nil {
+		q.Add("start_time", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("end_time", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.pageNum != nil {
+		q.Add("page_num", strconv.Itoa(*s.pageNum))
+	}
+	if s.pageSize != nil {
+		q.Add("page_size", strconv.Itoa(*s.pageSize))
+	}
+
+	return q
+}
@@ -0,0 +1,172 @@
+package rest
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlaceStopOrderService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewPlaceStopOrderService("key", "secret").
+			PositionID(1394650).
+			Vol(decimal.NewFromInt(1)).
+			StopLossPrice(decimal.NewFromInt(29000)).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewPlaceStopOrderService("key", "secret").
+			Vol(decimal.Zero).
+			LossTrend("MARK").
+			validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "positionId is required")
+		assert.Contains(t, err.Error(), "vol must be greater than zero")
+		assert.Contains(t, err.Error(), "stopLossPrice or takeProfitPrice is required")
+		assert.Contains(t, err.Error(), "lossTrend is invalid")
+	})
+}
+
+func TestPlaceStopOrderService_buildBody(t *testing.T) {
+	body := NewPlaceStopOrderService("key", "secret").
+		PositionID(1394650).
+		Vol(decimal.NewFromInt(3)).
+		StopLossPrice(decimal.RequireFromString("29000.5")).
+		TakeProfitPrice(decimal.NewFromInt(33000)).
+		LossTrend(TriggerPriceSourceFair).
+		ProfitTrend(TriggerPriceSourceLast).
+		buildBody()
+
+	assert.JSONEq(t, `{
+		"positionId": 1394650,
+		"vol": 3,
+		"stopLossPrice": 29000.5,
+		"takeProfitPrice": 33000,
+		"lossTrend": 2,
+		"profitTrend": 1
+	}`, string(body))
+}
+
+func TestPlaceStopOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/stoporder/place")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":555}`),
+			}, nil
+		},
+	}
+
+	id, err := NewPlaceStopOrderService("key", "secret").
+		WithClient(fakeClient).
+		PositionID(1).
+		Vol(decimal.NewFromInt(1)).
+		TakeProfitPrice(decimal.NewFromInt(33000)).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "555", id)
+}
+
+func TestChangeStopOrderPriceService(t *testing.T) {
+	t.Run("validate", func(t *testing.T) {
+		err := NewChangeStopOrderPriceService("key", "secret").Validate()
+
+		sdkErr, ok := err.(*sdkerr.SDKError)
+		require.True(t, ok)
+		assert.Equal(t, "ChangeStopOrderPriceService.Validate", sdkErr.Op())
+		assert.Contains(t, sdkErr.Message(), "stopPlanOrderId is required")
+		assert.Contains(t, sdkErr.Message(), "stopLossPrice or takeProfitPrice is required")
+	})
+
+	t.Run("do", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				assert.Contains(t, req.FullURL, "/api/v1/private/stoporder/change_plan_price")
+
+				body, err := io.ReadAll(req.Body)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"stopPlanOrderId":555,"stopLossPrice":29500}`, string(body))
+
+				return &transport.Response{StatusCode: 200, Body: []byte(`{"success":true,"code":0}`)}, nil
+			},
+		}
+
+		err := NewChangeStopOrderPriceService("key", "secret").
+			WithClient(fakeClient).
+			StopPlanOrderID(555).
+			StopLossPrice(decimal.NewFromInt(29500)).
+			Do(context.Background())
+		assert.NoError(t, err)
+	})
+}
+
+func TestStopOrdersService_buildQuery(t *testing.T) {
+	q := NewStopOrdersService("key", "secret").
+		Symbol("BTC_USDT").
+		IsFinished(false).
+		PageSize(10).
+		buildQuery()
+
+	assert.Equal(t, "BTC_USDT", q.Get("symbol"))
+	assert.Equal(t, "0", q.Get("is_finished"))
+	assert.Equal(t, "10", q.Get("page_size"))
+}
+
+func TestStopOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/stoporder/list/orders")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{"success":true,"code":0,"data":[{
+					"id": 555,
+					"orderId": 0,
+					"symbol": "BTC_USDT",
+					"positionId": 1394650,
+					"stopLossPrice": 29000,
+					"takeProfitPrice": 33000,
+					"state": 3,
+					"triggerSide": 1,
+					"positionType": 1,
+					"vol": 1,
+					"realityVol": 1,
+					"placeOrderId": 102067003631907840,
+					"errorCode": 0,
+					"isFinished": 1,
+					"createTime": 1609992674000,
+					"updateTime": 1609992694000
+				}]}`),
+			}, nil
+		},
+	}
+
+	orders, err := NewStopOrdersService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, orders, 1)
+
+	o := orders[0]
+	assert.Equal(t, "555", o.ID)
+	assert.Equal(t, int64(1394650), o.PositionId)
+	assert.Equal(t, PlanOrderStateExecuted, o.State)
+	assert.Equal(t, StopTriggerSideTakeProfit, o.TriggerSide)
+	assert.Equal(t, wsuser.PositionTypeLong, o.PositionType)
+	assert.Equal(t, "102067003631907840", o.PlaceOrderId)
+	assert.True(t, o.IsFinished)
+	testutil.AssertDecimalEqual(t, o.StopLossPrice, "29000", "stop loss mismatch")
+}
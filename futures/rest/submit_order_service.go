@@ -0,0 +1,283 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// orderRequest holds the parameters shared by single and batch order submission.
+type orderRequest struct {
+	symbol          string
+	price           *decimal.Decimal
+	vol             *decimal.Decimal
+	leverage        *int
+	side            wsuser.OrderSide
+	orderType       wsuser.OrderType
+	openType        wsuser.OpenType
+	positionID      *int64
+	externalOid     *string
+	stopLossPrice   *decimal.Decimal
+	takeProfitPrice *decimal.Decimal
+	reduceOnly      *bool
+}
+
+func (o *orderRequest) validate() []string {
+	var errs []string
+
+	if o.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if _, ok := orderSideCode(o.side); !ok {
+		errs = append(errs, "side is invalid")
+	}
+
+	if _, ok := orderTypeCode(o.orderType); !ok {
+		errs = append(errs, "type is invalid")
+	}
+
+	if _, ok := openTypeCode(o.openType); !ok {
+		errs = append(errs, "openType is invalid")
+	}
+
+	if o.vol == nil {
+		errs = append(errs, "vol is required")
+	} else if o.vol.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "vol must be greater than zero")
+	}
+
+	if o.price == nil {
+		if o.orderType != wsuser.OrderTypeMarket {
+			errs = append(errs, "price is required for non-market orders")
+		}
+	} else if o.price.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "price must be greater than zero")
+	}
+
+	if o.leverage != nil && *o.leverage < 1 {
+		errs = append(errs, "leverage must be at least 1")
+	}
+
+	// The exchange needs the leverage of an isolated position when opening it;
+	// cross positions and closing orders use the leverage already set.
+	if o.openType == wsuser.OpenTypeIsolated && isOpenSide(o.side) && o.leverage == nil {
+		errs = append(errs, "leverage is required when opening an isolated position")
+	}
+
+	if o.stopLossPrice != nil && o.stopLossPrice.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "stopLossPrice must be greater than zero")
+	}
+
+	if o.takeProfitPrice != nil && o.takeProfitPrice.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "takeProfitPrice must be greater than zero")
+	}
+
+	return errs
+}
+
+type orderParams struct {
+	Symbol          string      `json:"symbol"`
+	Price           json.Number `json:"price,omitempty"`
+	Vol             json.Number `json:"vol"`
+	Leverage        *int        `json:"leverage,omitempty"`
+	Side            int         `json:"side"`
+	Type            int         `json:"type"`
+	OpenType        int         `json:"openType"`
+	PositionID      *int64      `json:"positionId,omitempty"`
+	ExternalOid     string      `json:"externalOid,omitempty"`
+	StopLossPrice   json.Number `json:"stopLossPrice,omitempty"`
+	TakeProfitPrice json.Number `json:"takeProfitPrice,omitempty"`
+	ReduceOnly      *bool       `json:"reduceOnly,omitempty"`
+}
+
+func (o *orderRequest) params() orderParams {
+	side, _ := orderSideCode(o.side)
+	orderType, _ := orderTypeCode(o.orderType)
+	openType, _ := openTypeCode(o.openType)
+
+	p := orderParams{
+		Symbol:     o.symbol,
+		Leverage:   o.leverage,
+		Side:       side,
+		Type:       orderType,
+		OpenType:   openType,
+		PositionID: o.positionID,
+		ReduceOnly: o.reduceOnly,
+	}
+	if o.price != nil {
+		p.Price = json.Number(o.price.String())
+	}
+	if o.vol != nil {
+		p.Vol = json.Number(o.vol.String())
+	}
+	if o.externalOid != nil {
+		p.ExternalOid = *o.externalOid
+	}
+	if o.stopLossPrice != nil {
+		p.StopLossPrice = json.Number(o.stopLossPrice.String())
+	}
+	if o.takeProfitPrice != nil {
+		p.TakeProfitPrice = json.Number(o.takeProfitPrice.String())
+	}
+	return p
+}
+
+// SubmitOrderService places a new futures order.
+type SubmitOrderService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	order      orderRequest
+}
+
+// NewSubmitOrderService creates a new SubmitOrderService.
+func NewSubmitOrderService(apiKey, secretKey string) *SubmitOrderService {
+	return &SubmitOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey, secretKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *SubmitOrderService) WithClient(client transport.HTTPClient) *SubmitOrderService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the contract of the order.
+func (s *SubmitOrderService) Symbol(symbol string) *SubmitOrderService {
+	s.order.symbol = symbol
+	return s
+}
+
+// Price sets the price of the order.
+func (s *SubmitOrderService) Price(price decimal.Decimal) *SubmitOrderService {
+	s.order.price = &price
+	return s
+}
+
+// Vol sets the volume of the order in contracts.
+func (s *SubmitOrderService) Vol(vol decimal.Decimal) *SubmitOrderService {
+	s.order.vol = &vol
+	return s
+}
+
+// Leverage sets the leverage of the order. Required when opening an isolated position.
+func (s *SubmitOrderService) Leverage(leverage int) *SubmitOrderService {
+	s.order.leverage = &leverage
+	return s
+}
+
+// Side sets the side of the order.
+func (s *SubmitOrderService) Side(side wsuser.OrderSide) *SubmitOrderService {
+	s.order.side = side
+	return s
+}
+
+// Type sets the type of the order.
+func (s *SubmitOrderService) Type(orderType wsuser.OrderType) *SubmitOrderService {
+	s.order.orderType = orderType
+	return s
+}
+
+// OpenType sets the margin mode of the order.
+func (s *SubmitOrderService) OpenType(openType wsuser.OpenType) *SubmitOrderService {
+	s.order.openType = openType
+	return s
+}
+
+// PositionID sets the position to close. Recommended for closing orders.
+func (s *SubmitOrderService) PositionID(id int64) *SubmitOrderService {
+	s.order.positionID = &id
+	return s
+}
+
+// ExternalOid sets the client-assigned order ID.
+func (s *SubmitOrderService) ExternalOid(oid string) *SubmitOrderService {
+	s.order.externalOid = &oid
+	return s
+}
+
+// StopLossPrice attaches a stop-loss price to the order.
+func (s *SubmitOrderService) StopLossPrice(price decimal.Decimal) *SubmitOrderService {
+	s.order.stopLossPrice = &price
+	return s
+}
+
+// TakeProfitPrice attaches a take-profit price to the order.
+func (s *SubmitOrderService) TakeProfitPrice(price decimal.Decimal) *SubmitOrderService {
+	s.order.takeProfitPrice = &price
+	return s
+}
+
+// ReduceOnly sets whether the order may only reduce a position.
+func (s *SubmitOrderService) ReduceOnly(reduceOnly bool) *SubmitOrderService {
+	s.order.reduceOnly = &reduceOnly
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *SubmitOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("SubmitOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service and returns the ID of the placed order.
+func (s *SubmitOrderService) Do(ctx context.Context) (string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v1/private/order/submit").
+		WithBody(s.buildBody()).
+		Build()
+
+	op := "SubmitOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orderID, err := decodeResponse[json.Number](resp.Body, op)
+	if err != nil {
+		return "", err
+	}
+	return orderID.String(), nil
+}
+
+func (s *SubmitOrderService) validate() error {
+	if errs := s.order.validate(); len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *SubmitOrderService) buildBody() []byte {
+	// Marshalling cannot fail: orderParams holds only plain values.
+	body, _ := json.Marshal(s.order.params())
+	return body
+}
@@ -0,0 +1,166 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/futures/wsuser"
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitOrderService_validate(t *testing.T) {
+	valid := func() *SubmitOrderService {
+		return NewSubmitOrderService("key", "secret").
+			Symbol("BTC_USDT").
+			Price(decimal.RequireFromString("30000")).
+			Vol(decimal.NewFromInt(1)).
+			Side(wsuser.OrderSideOpenLong).
+			Type(wsuser.OrderTypeLimit).
+			OpenType(wsuser.OpenTypeIsolated).
+			Leverage(20)
+	}
+
+	tests := []struct {
+		name    string
+		svc     *SubmitOrderService
+		wantErr string
+	}{
+		{"valid isolated open", valid(), ""},
+		{"cross open without leverage", valid().OpenType(wsuser.OpenTypeCross), ""},
+		{
+			name: "isolated close without leverage",
+			svc: NewSubmitOrderService("key", "secret").Symbol("BTC_USDT").
+				Price(decimal.NewFromInt(1)).Vol(decimal.NewFromInt(1)).
+				Side(wsuser.OrderSideCloseLong).Type(wsuser.OrderTypeLimit).OpenType(wsuser.OpenTypeIsolated),
+		},
+		{
+			name: "market order without price",
+			svc: NewSubmitOrderService("key", "secret").Symbol("BTC_USDT").
+				Vol(decimal.NewFromInt(1)).Side(wsuser.OrderSideOpenShort).
+				Type(wsuser.OrderTypeMarket).OpenType(wsuser.OpenTypeCross),
+		},
+		{
+			name: "isolated open without leverage",
+			svc: NewSubmitOrderService("key", "secret").Symbol("BTC_USDT").
+				Price(decimal.NewFromInt(1)).Vol(decimal.NewFromInt(1)).
+				Side(wsuser.OrderSideOpenShort).Type(wsuser.OrderTypeLimit).OpenType(wsuser.OpenTypeIsolated),
+			wantErr: "leverage is required when opening an isolated position",
+		},
+		{"zero leverage", valid().Leverage(0), "leverage must be at least 1"},
+		{"missing open type", valid().OpenType(""), "openType is invalid"},
+		{"invalid side", valid().Side("BUY"), "side is invalid"},
+		{"invalid type", valid().Type("STOP"), "type is invalid"},
+		{"limit order without price", NewSubmitOrderService("key", "secret").Symbol("BTC_USDT").
+			Vol(decimal.NewFromInt(1)).Side(wsuser.OrderSideOpenLong).Type(wsuser.OrderTypeLimit).
+			OpenType(wsuser.OpenTypeCross), "price is required for non-market orders"},
+		{"non-positive vol", valid().Vol(decimal.Zero), "vol must be greater than zero"},
+		{"non-positive stop loss", valid().StopLossPrice(decimal.NewFromInt(-1)), "stopLossPrice must be greater than zero"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.svc.validate()
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestSubmitOrderService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewSubmitOrderService("key", "secret").Validate()
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	require.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "SubmitOrderService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+	assert.Contains(t, sdkErr.Message(), "vol is required")
+}
+
+func TestSubmitOrderService_buildBody(t *testing.T) {
+	body := NewSubmitOrderService("key", "secret").
+		Symbol("BTC_USDT").
+		Price(decimal.RequireFromString("30000.5")).
+		Vol(decimal.NewFromInt(3)).
+		Side(wsuser.OrderSideOpenShort).
+		Type(wsuser.OrderTypeImmediateOrCancel).
+		OpenType(wsuser.OpenTypeIsolated).
+		Leverage(10).
+		ExternalOid("my-order").
+		TakeProfitPrice(decimal.RequireFromString("28000")).
+		buildBody()
+
+	assert.JSONEq(t, `{
+		"symbol": "BTC_USDT",
+		"price": 30000.5,
+		"vol": 3,
+		"leverage": 10,
+		"side": 3,
+		"type": 3,
+		"openType": 1,
+		"externalOid": "my-order",
+		"takeProfitPrice": 28000
+	}`, string(body))
+}
+
+func TestSubmitOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/private/order/submit")
+			assert.Equal(t, "key", req.Headers.Get("ApiKey"))
+			assert.NotEmpty(t, req.Headers.Get("Signature"))
+
+			body, err := io.ReadAll(req.Body)
+			require.NoError(t, err)
+			var params map[string]any
+			require.NoError(t, json.Unmarshal(body, &params))
+			assert.Equal(t, "BTC_USDT", params["symbol"])
+			assert.Equal(t, float64(5), params["type"])
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":739113577038255616}`),
+			}, nil
+		},
+	}
+
+	orderID, err := NewSubmitOrderService("key", "secret").
+		WithClient(fakeClient).
+		Symbol("BTC_USDT").
+		Vol(decimal.NewFromInt(1)).
+		Side(wsuser.OrderSideOpenLong).
+		Type(wsuser.OrderTypeMarket).
+		OpenType(wsuser.OpenTypeCross).
+		Do(context.Background())
+
+	require.NoError(t, err)
+	assert.Equal(t, "739113577038255616", orderID)
+}
+
+func TestSubmitOrderService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"success":false,"code":2005}`)}, nil
+		},
+	}
+
+	orderID, err := NewSubmitOrderService("key", "secret").WithClient(fakeClient).Do(context.Background())
+	assert.Empty(t, orderID)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+}
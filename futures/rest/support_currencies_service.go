@@ -0,0 +1,62 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// SupportCurrenciesService gets the currencies that can be transferred to futures.
+type SupportCurrenciesService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewSupportCurrenciesService creates a new SupportCurrenciesService.
+func NewSupportCurrenciesService() *SupportCurrenciesService {
+	return &SupportCurrenciesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *SupportCurrenciesService) WithClient(client transport.HTTPClient) *SupportCurrenciesService {
+	s.client = client
+	return s
+}
+
+// Do executes the service.
+func (s *SupportCurrenciesService) Do(ctx context.Context) ([]string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/contract/support_currencies").
+		Build()
+
+	op := "SupportCurrenciesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	currencies, err := decodeResponse[[]string](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *currencies, nil
+}
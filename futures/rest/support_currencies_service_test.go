@@ -0,0 +1,45 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSupportCurrenciesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/support_currencies")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":["BTC","ETH","USDT"]}`),
+			}, nil
+		},
+	}
+
+	currencies, err := NewSupportCurrenciesService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BTC", "ETH", "USDT"}, currencies)
+}
+
+func TestSupportCurrenciesService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 500, Body: []byte(`not-json`)}, nil
+		},
+	}
+
+	currencies, err := NewSupportCurrenciesService().WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, currencies)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+}
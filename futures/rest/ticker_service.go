@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Ticker represents the market summary of a contract.
+type Ticker struct {
+	Symbol        string          `json:"symbol"`
+	LastPrice     decimal.Decimal `json:"lastPrice"`
+	Bid1          decimal.Decimal `json:"bid1"`
+	Ask1          decimal.Decimal `json:"ask1"`
+	Volume24      decimal.Decimal `json:"volume24"`
+	Amount24      decimal.Decimal `json:"amount24"`
+	HoldVol       decimal.Decimal `json:"holdVol"`
+	Lower24Price  decimal.Decimal `json:"lower24Price"`
+	High24Price   decimal.Decimal `json:"high24Price"`
+	RiseFallRate  decimal.Decimal `json:"riseFallRate"`
+	RiseFallValue decimal.Decimal `json:"riseFallValue"`
+	IndexPrice    decimal.Decimal `json:"indexPrice"`
+	FairPrice     decimal.Decimal `json:"fairPrice"`
+	FundingRate   decimal.Decimal `json:"fundingRate"`
+	MaxBidPrice   decimal.Decimal `json:"maxBidPrice"`
+	MinAskPrice   decimal.Decimal `json:"minAskPrice"`
+	Timestamp     int64           `json:"timestamp"`
+}
+
+// TickerService gets the ticker of one or all contracts.
+type TickerService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     *string
+}
+
+// NewTickerService creates a new TickerService.
+func NewTickerService() *TickerService {
+	return &TickerService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder("", ""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TickerService) WithClient(client transport.HTTPClient) *TickerService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single contract.
+func (s *TickerService) Symbol(symbol string) *TickerService {
+	s.symbol = &symbol
+	return s
+}
+
+// Do executes the service.
+func (s *TickerService) Do(ctx context.Context) ([]Ticker, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v1/contract/ticker").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TickerService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeOneOrMany[Ticker](resp.Body, s.symbol != nil, op)
+}
+
+func (s *TickerService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	return q
+}
@@ -0,0 +1,78 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tickerJSON = `{
+	"symbol": "BTC_USDT",
+	"lastPrice": 30971.6,
+	"bid1": 30971.5,
+	"ask1": 30971.7,
+	"volume24": 165283461,
+	"amount24": 511234567.12,
+	"holdVol": 4023847,
+	"lower24Price": 30500.1,
+	"high24Price": 31500.2,
+	"riseFallRate": 0.0123,
+	"riseFallValue": 376.5,
+	"indexPrice": 30972.1,
+	"fairPrice": 30971.9,
+	"fundingRate": 0.0001,
+	"maxBidPrice": 34068.8,
+	"minAskPrice": 27874.4,
+	"timestamp": 1658996910762
+}`
+
+func TestTickerService_Do_Single(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v1/contract/ticker")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTC_USDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":` + tickerJSON + `}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTickerService().WithClient(fakeClient).Symbol("BTC_USDT").Do(context.Background())
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	tk := result[0]
+	assert.Equal(t, "BTC_USDT", tk.Symbol)
+	testutil.AssertDecimalEqual(t, tk.LastPrice, "30971.6", "last price mismatch")
+	testutil.AssertDecimalEqual(t, tk.Amount24, "511234567.12", "amount24 mismatch")
+	testutil.AssertDecimalEqual(t, tk.FundingRate, "0.0001", "funding rate mismatch")
+	assert.Equal(t, int64(1658996910762), tk.Timestamp)
+}
+
+func TestTickerService_Do_All(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.False(t, query.Has("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"success":true,"code":0,"data":[` + tickerJSON + `]}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTickerService().WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, result, 1)
+}
@@ -0,0 +1,62 @@
+package wsmarket
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// dropClient reports dropped frames before reading from the pipe.
+type dropClient struct {
+	*testutil.PipeClient
+	drops chan uint64
+}
+
+func (c *dropClient) ReadMessage() ([]byte, error) {
+	select {
+	case n := <-c.drops:
+		return nil, &ws.DropError{Count: n}
+	default:
+	}
+	return c.PipeClient.ReadMessage()
+}
+
+func TestWSMarket_FramesDropped_InvalidatesDepth(t *testing.T) {
+	client := &dropClient{PipeClient: ackingClient(), drops: make(chan uint64, 1)}
+
+	dropped := make(chan uint64, 1)
+	w := NewWSMarketWithFactory(func(string) ws.Client { return client },
+		WithOnFramesDropped(func(n uint64) { dropped <- n }),
+	)
+	require.NoError(t, w.Connect(context.Background()))
+	defer w.Close()
+
+	invalid := make(chan error, 1)
+	_, err := w.Subscribe(context.Background(), NewBookDepthSub("BTC_USDT", func(*DepthSnapshot) {}).
+		SetOnInvalid(func(err error) { invalid <- err }))
+	require.NoError(t, err)
+
+	client.drops <- 3
+	// Wake the reader blocked on the pipe.
+	client.Push([]byte(`{"channel":"pong","data":1}`))
+
+	select {
+	case n := <-dropped:
+		assert.Equal(t, uint64(3), n)
+	case <-time.After(time.Second):
+		t.Fatal("drop not reported")
+	}
+
+	select {
+	case err := <-invalid:
+		assert.ErrorIs(t, err, ws.ErrFramesDropped)
+	case <-time.After(time.Second):
+		t.Fatal("depth subscription not invalidated")
+	}
+	assert.True(t, w.connected.Load())
+}
@@ -45,6 +45,12 @@ func (d *bookDepthSub) SetOnInvalid(f func(error)) Subscription {
 	return d
 }
 
+func (d *bookDepthSub) reportInvalid(err error) {
+	if d.onInvalid != nil {
+		d.onInvalid(err)
+	}
+}
+
 func (d *bookDepthSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+d.channel() {
 		var s string
@@ -83,6 +89,12 @@ func (d *bookDepthSub) handleEvent(msg *message) {
 	d.onData(depth)
 }
 
+// invalidate reports lost frames through the onInvalid callback, since the
+// depth state can no longer be trusted.
+func (d *bookDepthSub) invalidate(err error) {
+	d.reportInvalid(err)
+}
+
 func (d *bookDepthSub) id() string {
 	return fmt.Sprintf("%s@%s", d.channel(), d.symbol)
 }
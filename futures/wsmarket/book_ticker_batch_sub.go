@@ -37,6 +37,12 @@ func (b *bookTickerBatchSub) SetOnInvalid(f func(error)) Subscription {
 	return b
 }
 
+func (b *bookTickerBatchSub) reportInvalid(err error) {
+	if b.onInvalid != nil {
+		b.onInvalid(err)
+	}
+}
+
 func (b *bookTickerBatchSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+b.channel() {
 		var s string
@@ -45,6 +45,12 @@ func (s *fairPriceSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *fairPriceSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *fairPriceSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+s.channel() {
 		var r string
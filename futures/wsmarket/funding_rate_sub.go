@@ -45,6 +45,12 @@ func (s *fundingRateSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *fundingRateSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *fundingRateSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+s.channel() {
 		var r string
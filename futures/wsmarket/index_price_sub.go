@@ -45,6 +45,12 @@ func (s *indexPriceSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *indexPriceSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *indexPriceSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+s.channel() {
 		var r string
@@ -79,6 +79,12 @@ func (k *klineSub) SetOnInvalid(f func(error)) Subscription {
 	return k
 }
 
+func (k *klineSub) reportInvalid(err error) {
+	if k.onInvalid != nil {
+		k.onInvalid(err)
+	}
+}
+
 func (k *klineSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+k.channel() {
 		var r string
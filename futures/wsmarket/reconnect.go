@@ -0,0 +1,169 @@
+package wsmarket
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+)
+
+func (w *WSMarket) getClient() ws.Client {
+	w.clientMu.RLock()
+	defer w.clientMu.RUnlock()
+	return w.client
+}
+
+// swapClient installs client as the active connection and marks it pending
+// until settlePending. It returns false if the WSMarket was closed in the
+// meantime.
+func (w *WSMarket) swapClient(client ws.Client) bool {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	if w.closed.Load() {
+		return false
+	}
+	w.client = client
+	w.pending, w.pendingErr = client, nil
+	return true
+}
+
+// releaseReader reports whether the reader of client should stop without
+// starting a reconnect: either client was replaced, or reconnectLoop is still
+// restoring it and takes over err.
+func (w *WSMarket) releaseReader(client ws.Client, err error) bool {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	if client == w.pending {
+		w.pendingErr = err
+		return true
+	}
+	return client != w.client
+}
+
+// settlePending hands the pending connection over to its reader and returns
+// the error the reader reported while the connection was being restored.
+func (w *WSMarket) settlePending() error {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	err := w.pendingErr
+	w.pending, w.pendingErr = nil, nil
+	return err
+}
+
+func (w *WSMarket) publishState(e ws.StateEvent) {
+	if w.onStateChange != nil {
+		w.onStateChange(e)
+	}
+}
+
+// dropConnection closes the active connection so that its reader starts a
+// reconnect.
+func (w *WSMarket) dropConnection() {
+	w.connected.Store(false)
+	_ = w.getClient().Close()
+}
+
+// reconnectLoop replaces the failed connection old with a new one from the
+// factory and restores every active subscription on it.
+func (w *WSMarket) reconnectLoop(ctx context.Context, old ws.Client, cause error) {
+	_ = old.Close()
+
+	for attempt := 1; ; attempt++ {
+		if w.reconnect.Exhausted(attempt) {
+			_ = w.shutdown(cause)
+			return
+		}
+
+		w.publishState(ws.StateEvent{State: ws.StateReconnecting, Attempt: attempt, Err: cause})
+
+		select {
+		case <-ctx.Done():
+			_ = w.shutdown(ctx.Err())
+			return
+		case <-time.After(w.reconnect.Delay(attempt)):
+		}
+
+		if w.closed.Load() {
+			return
+		}
+
+		w.publishState(ws.StateEvent{State: ws.StateConnecting, Attempt: attempt})
+
+		client := w.factory(w.url)
+		if err := client.Connect(ctx); err != nil {
+			cause = w.errFactory("reconnect", sdkerr.ErrWSConnection, err)
+			continue
+		}
+
+		if !w.swapClient(client) {
+			_ = client.Close()
+			return
+		}
+
+		w.readingMessage(ctx, client)
+
+		err := w.resubscribe(ctx)
+		if err == nil {
+			err = w.settlePending()
+		}
+		if err != nil {
+			// The reader of a pending connection stops quietly, so a failed
+			// restore counts as a failed attempt of this loop.
+			cause = err
+			_ = client.Close()
+			continue
+		}
+
+		w.connected.Store(true)
+		w.publishState(ws.StateEvent{State: ws.StateConnected, Attempt: attempt})
+		return
+	}
+}
+
+// resubscribe sends every active subscription on the current connection.
+// A subscription the server rejects is dropped and reported through its
+// onInvalid callback; any other error fails the attempt.
+func (w *WSMarket) resubscribe(ctx context.Context) error {
+	w.activeSubsMu.Lock()
+	wrappers := make([]*subscriptionWrapper, 0, len(w.activeSubs))
+	for _, h := range w.activeSubs {
+		if wrapper, ok := h.(*subscriptionWrapper); ok {
+			wrappers = append(wrappers, wrapper)
+		}
+	}
+	w.activeSubsMu.Unlock()
+
+	for _, wrapper := range wrappers {
+		req := newSubscriptionRequest(subscribe, wrapper.inner)
+
+		reqCtx, cancel := context.WithTimeout(ctx, w.waitingTimeout)
+		err := w.sendAndAwaitResponse(reqCtx, req)
+		cancel()
+		if errors.Is(err, sdkerr.ErrWSServerError) {
+			wrapper.reject(err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if !w.isActive(wrapper) {
+			// Unsubscribe ran while the request was in flight.
+			if msg, err := newSubscriptionRequest(unsubscribe, wrapper.inner).Message(); err == nil {
+				_ = w.getClient().WriteMessage(msg)
+			}
+		}
+	}
+	return nil
+}
+
+func (w *WSMarket) isActive(wrapper *subscriptionWrapper) bool {
+	w.activeSubsMu.Lock()
+	defer w.activeSubsMu.Unlock()
+	return w.activeSubs[wrapper.inner.id()] == SubscriptionHandle(wrapper)
+}
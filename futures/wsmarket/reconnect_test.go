@@ -0,0 +1,345 @@
+package wsmarket
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ackingClient acknowledges every depth subscription.
+func ackingClient() *testutil.PipeClient {
+	c := testutil.NewPipeClient()
+	c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+		if strings.Contains(string(msg), `"sub.depth"`) {
+			c.Push([]byte(`{"channel":"rs.sub.depth","data":"success"}`))
+		}
+	}
+	return c
+}
+
+type stateRecorder struct {
+	mu     sync.Mutex
+	events []ws.StateEvent
+}
+
+func (r *stateRecorder) record(e ws.StateEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *stateRecorder) states() []ws.ConnState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []ws.ConnState
+	for _, e := range r.events {
+		out = append(out, e.State)
+	}
+	return out
+}
+
+func TestWSMarket_Reconnect_Resubscribes(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		clients []*testutil.PipeClient
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		c := ackingClient()
+		clients = append(clients, c)
+		return c
+	}
+	client := func(i int) *testutil.PipeClient {
+		mu.Lock()
+		defer mu.Unlock()
+		if i >= len(clients) {
+			return nil
+		}
+		return clients[i]
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(states.record),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+
+	received := make(chan *DepthSnapshot, 1)
+	handle, err := w.Subscribe(context.Background(), NewBookDepthSub("BTC_USDT", func(d *DepthSnapshot) {
+		received <- d
+	}))
+	require.NoError(t, err)
+
+	_ = client(0).Close()
+
+	require.Eventually(t, func() bool {
+		c := client(1)
+		return c != nil && len(c.Writes()) == 1
+	}, time.Second, time.Millisecond)
+	assert.Contains(t, string(client(1).Writes()[0]), `"sub.depth"`)
+
+	require.Eventually(t, func() bool {
+		s := states.states()
+		return s[len(s)-1] == ws.StateConnected
+	}, time.Second, time.Millisecond)
+	assert.Contains(t, states.states(), ws.StateReconnecting)
+
+	client(1).Push([]byte(`{"channel":"push.depth","symbol":"BTC_USDT","ts":1,"data":{"asks":[],"bids":[],"version":7}}`))
+	select {
+	case d := <-received:
+		assert.Equal(t, int64(7), d.Version)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not routed after reconnect")
+	}
+
+	require.NoError(t, handle.Unsubscribe(context.Background()))
+	assert.Contains(t, string(client(1).Writes()[1]), `"unsub.depth"`)
+
+	require.NoError(t, w.Close())
+	s := states.states()
+	assert.Equal(t, ws.StateClosed, s[len(s)-1])
+}
+
+func TestWSMarket_Reconnect_GivesUpAfterMaxAttempts(t *testing.T) {
+	first := testutil.NewPipeClient()
+	calls := 0
+	factory := func(url string) ws.Client {
+		calls++
+		if calls == 1 {
+			return first
+		}
+		c := testutil.NewPipeClient()
+		c.ConnectErr = fakeErr
+		return c
+	}
+
+	closed := make(chan ws.StateEvent, 1)
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond, MaxAttempts: 2}),
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateClosed {
+				closed <- e
+			}
+		}),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = first.Close()
+
+	select {
+	case e := <-closed:
+		assert.ErrorIs(t, e.Err, fakeErr)
+	case <-time.After(time.Second):
+		t.Fatal("client did not give up")
+	}
+	assert.Equal(t, 3, calls)
+}
+
+func TestWSMarket_NoReconnect_ClosesOnReadError(t *testing.T) {
+	client := testutil.NewPipeClient()
+	closed := make(chan struct{})
+	w := NewWSMarketWithFactory(func(string) ws.Client { return client },
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateClosed {
+				close(closed)
+			}
+		}),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = client.Close()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("client not closed")
+	}
+}
+
+func TestWSMarket_Lifetime(t *testing.T) {
+	t.Run("dial ctx does not bound the connection", func(t *testing.T) {
+		client := testutil.NewPipeClient()
+		w := NewWSMarketWithFactory(func(string) ws.Client { return client })
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+		require.NoError(t, w.Connect(ctx))
+
+		<-ctx.Done()
+		select {
+		case <-w.Done():
+			t.Fatal("client closed when the dial ctx expired")
+		case <-time.After(50 * time.Millisecond):
+		}
+		assert.NoError(t, w.Err())
+		assert.False(t, client.IsClosed())
+
+		require.NoError(t, w.Close())
+		<-w.Done()
+		assert.ErrorIs(t, w.Err(), ws.ErrClosed)
+	})
+
+	t.Run("lifetime ctx closes the client", func(t *testing.T) {
+		client := testutil.NewPipeClient()
+		lifetime, cancel := context.WithCancel(context.Background())
+		w := NewWSMarketWithFactory(func(string) ws.Client { return client }, WithLifetime(lifetime))
+
+		require.NoError(t, w.Connect(context.Background()))
+		cancel()
+
+		select {
+		case <-w.Done():
+		case <-time.After(time.Second):
+			t.Fatal("client not closed")
+		}
+		assert.ErrorIs(t, w.Err(), context.Canceled)
+		assert.True(t, client.IsClosed())
+	})
+
+	t.Run("read error ends the client", func(t *testing.T) {
+		client := testutil.NewPipeClient()
+		w := NewWSMarketWithFactory(func(string) ws.Client { return client })
+
+		require.NoError(t, w.Connect(context.Background()))
+		_ = client.Close()
+
+		select {
+		case <-w.Done():
+		case <-time.After(time.Second):
+			t.Fatal("client not closed")
+		}
+		assert.ErrorIs(t, w.Err(), testutil.ErrPipeClosed)
+	})
+
+	t.Run("client without ws.Lifecycle", func(t *testing.T) {
+		// Embedding the interface hides Done and Err of the pipe.
+		client := struct{ ws.Client }{testutil.NewPipeClient()}
+		w := NewWSMarketWithFactory(func(string) ws.Client { return client })
+
+		require.NoError(t, w.Connect(context.Background()))
+		require.NoError(t, w.Close())
+		<-w.Done()
+		assert.ErrorIs(t, w.Err(), ws.ErrClosed)
+	})
+}
+
+func TestWSMarket_Reconnect_FailedResubscribeCountsAsAttempt(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return ackingClient()
+		}
+		// Connects, then fails the resubscribe write.
+		c := testutil.NewPipeClient()
+		_ = c.Close()
+		return c
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond, MaxAttempts: 2}),
+		WithOnStateChange(states.record),
+	)
+	require.NoError(t, w.Connect(context.Background()))
+
+	_, err := w.Subscribe(context.Background(), NewBookDepthSub("BTC_USDT", func(*DepthSnapshot) {}))
+	require.NoError(t, err)
+
+	_ = w.getClient().Close()
+
+	select {
+	case <-w.Done():
+	case <-time.After(time.Second):
+		t.Fatal("client did not give up")
+	}
+	assert.ErrorIs(t, w.Err(), testutil.ErrPipeClosed)
+
+	s := states.states()
+	assert.Equal(t, ws.StateClosed, s[len(s)-1])
+
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, 3, calls)
+	mu.Unlock()
+}
+
+func TestWSMarket_Reconnect_RejectedResubscribeInvalidatesSubscription(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return ackingClient()
+		}
+		// Rejects BTC_USDT and acknowledges everything else.
+		c := testutil.NewPipeClient()
+		c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+			if strings.Contains(string(msg), "BTC_USDT") {
+				c.Push([]byte(`{"channel":"rs.error","data":"sub failed"}`))
+				return
+			}
+			c.Push([]byte(`{"channel":"rs.sub.depth","data":"success"}`))
+		}
+		return c
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(states.record),
+	)
+	require.NoError(t, w.Connect(context.Background()))
+	defer w.Close()
+
+	invalid := make(chan error, 1)
+	rejected, err := w.Subscribe(context.Background(), NewBookDepthSub("BTC_USDT", func(*DepthSnapshot) {}).
+		SetOnInvalid(func(err error) { invalid <- err }))
+	require.NoError(t, err)
+	_, err = w.Subscribe(context.Background(), NewBookDepthSub("ETH_USDT", func(*DepthSnapshot) {}))
+	require.NoError(t, err)
+
+	_ = w.getClient().Close()
+
+	select {
+	case err := <-invalid:
+		assert.ErrorIs(t, err, sdkerr.ErrWSServerError)
+	case <-time.After(time.Second):
+		t.Fatal("rejected subscription not invalidated")
+	}
+
+	require.Eventually(t, func() bool {
+		s := states.states()
+		return s[len(s)-1] == ws.StateConnected
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, w.Err())
+
+	w.activeSubsMu.Lock()
+	assert.Len(t, w.activeSubs, 1)
+	w.activeSubsMu.Unlock()
+
+	assert.NoError(t, rejected.Unsubscribe(context.Background()))
+	mu.Lock()
+	assert.Equal(t, 2, calls)
+	mu.Unlock()
+}
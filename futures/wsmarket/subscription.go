@@ -16,6 +16,8 @@ type subscriptionSpec interface {
 	matches(msg *message) (bool, error)
 	payload(op subscriptionOp) any
 	id() string
+	// reportInvalid passes err to the onInvalid callback, if any.
+	reportInvalid(err error)
 }
 
 type subscriptionOp string
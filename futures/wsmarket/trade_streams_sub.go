@@ -70,6 +70,12 @@ func (t *tradeStreamsSub) SetOnInvalid(f func(error)) Subscription {
 	return t
 }
 
+func (t *tradeStreamsSub) reportInvalid(err error) {
+	if t.onInvalid != nil {
+		t.onInvalid(err)
+	}
+}
+
 func (t *tradeStreamsSub) matches(msg *message) (bool, error) {
 	if msg.Channel == "rs.sub."+t.channel() {
 		var s string
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/IvanTurko/mexc-sdk-go/internal/wsutil"
@@ -48,6 +49,12 @@ type SubscriptionHandle interface {
 // WSMarket is a WebSocket client for MEXC FUTURES market streams.
 type WSMarket struct {
 	client         ws.Client
+	clientMu       sync.RWMutex
+	pending        ws.Client // connection being restored by reconnectLoop
+	pendingErr     error
+	factory        func(url string) ws.Client
+	clientOpts     []ws.Option
+	url            string
 	waitingTimeout time.Duration
 
 	internalTimeout time.Duration
@@ -55,6 +62,11 @@ type WSMarket struct {
 	now             func() time.Time
 	onDisconnect    func(err error)
 	onLatency       func(latency time.Duration)
+	onFramesDropped func(n uint64)
+	onStateChange   func(ws.StateEvent)
+	reconnect       *ws.Backoff
+	connected       atomic.Bool
+	closed          atomic.Bool
 
 	activeSubs   map[string]SubscriptionHandle
 	activeSubsMu sync.Mutex
@@ -64,6 +76,8 @@ type WSMarket struct {
 	promiseFunc func(matchFn func(*message) (bool, error)) wsutil.Promise[message]
 
 	router    handlerRouter
+	lifetime  context.Context
+	life      wsutil.Lifecycle
 	closeOnce sync.Once
 	closeErr  error
 }
@@ -71,10 +85,7 @@ type WSMarket struct {
 // NewWSMarket creates a WSMarket using the default WebSocket client.
 // Additional configuration can be supplied through Options.
 func NewWSMarket(opts ...Options) *WSMarket {
-	factory := func(url string) ws.Client {
-		return ws.NewClient(url)
-	}
-	return NewWSMarketWithFactory(factory, opts...)
+	return newWSMarket(nil, opts...)
 }
 
 // NewWSMarketWithFactory is like NewWSMarket but uses the provided ws.Client factory.
@@ -83,9 +94,13 @@ func NewWSMarketWithFactory(factory func(url string) ws.Client, opts ...Options)
 	if factory == nil {
 		panic("NewWSMarketWithFactory: factory must not be nil")
 	}
+	return newWSMarket(factory, opts...)
+}
 
+func newWSMarket(factory func(url string) ws.Client, opts ...Options) *WSMarket {
 	w := &WSMarket{
-		client:         factory(defaultBaseURL),
+		factory:        factory,
+		url:            defaultBaseURL,
 		waitingTimeout: 1 * time.Second,
 
 		internalTimeout: 1 * time.Second,
@@ -102,9 +117,25 @@ func NewWSMarketWithFactory(factory func(url string) ws.Client, opts ...Options)
 		opt(w)
 	}
 
+	if w.factory == nil {
+		w.factory = func(url string) ws.Client {
+			return ws.NewClient(url, w.clientOpts...)
+		}
+	}
+	w.client = w.factory(w.url)
+
 	return w
 }
 
+// WithClientOptions sets the ws.Options (proxy, TLS, headers, limits, ...)
+// used to create connections. They are ignored when the WSMarket was created
+// with NewWSMarketWithFactory.
+func WithClientOptions(opts ...ws.Option) Options {
+	return func(w *WSMarket) {
+		w.clientOpts = opts
+	}
+}
+
 // WithOnDisconnect registers a callback for unexpected disconnections.
 func WithOnDisconnect(f func(err error)) Options {
 	return func(w *WSMarket) {
@@ -119,28 +150,110 @@ func WithPingLatencyHandler(f func(time.Duration)) Options {
 	}
 }
 
+// WithOnFramesDropped registers a callback for frames dropped by the ws.Client
+// backpressure policy (see ws.WithBackpressure). Depth subscriptions are
+// additionally invalidated through their SetOnInvalid callback.
+func WithOnFramesDropped(f func(n uint64)) Options {
+	return func(w *WSMarket) {
+		w.onFramesDropped = f
+	}
+}
+
+// WithReconnect enables automatic reconnection after the connection drops.
+// Reconnect attempts are spaced by b. Once reconnected, every active
+// subscription is sent again and existing SubscriptionHandles stay valid.
+// A subscription the server rejects at that point is dropped and reported
+// through its SetOnInvalid callback.
+func WithReconnect(b ws.Backoff) Options {
+	return func(w *WSMarket) {
+		w.reconnect = &b
+	}
+}
+
+// WithOnStateChange registers a callback for connection state changes.
+func WithOnStateChange(f func(ws.StateEvent)) Options {
+	return func(w *WSMarket) {
+		w.onStateChange = f
+	}
+}
+
+// WithLifetime binds the connection to ctx: once ctx is done the client is
+// closed. By default it runs until Close is called.
+func WithLifetime(ctx context.Context) Options {
+	return func(w *WSMarket) {
+		w.lifetime = ctx
+	}
+}
+
 // Connect opens the WebSocket connection and starts internal workers.
+//
+// ctx only bounds the dial. The connection runs until Close is called, it
+// fails for good or the WithLifetime context is done; see Done and Err.
 func (w *WSMarket) Connect(ctx context.Context) error {
-	err := w.client.Connect(ctx)
+	w.publishState(ws.StateEvent{State: ws.StateConnecting})
+
+	client := w.getClient()
+	err := client.Connect(ctx)
 	if err != nil {
 		return w.errFactory("Connect", sdkerr.ErrWSConnection, err)
 	}
-	w.readingMessage(ctx)
-	w.startPinger(ctx)
+	w.connected.Store(true)
+	lifetime := w.lifetimeContext()
+	w.readingMessage(lifetime, client)
+	w.startPinger(lifetime)
+	context.AfterFunc(lifetime, func() {
+		_ = w.shutdown(lifetime.Err())
+	})
+
+	w.publishState(ws.StateEvent{State: ws.StateConnected})
 	return nil
 }
 
 // Close shuts down the connection and internal workers. Safe to call multiple times.
 func (w *WSMarket) Close() error {
+	return w.shutdown(nil)
+}
+
+func (w *WSMarket) shutdown(cause error) error {
 	w.closeOnce.Do(func() {
-		err := w.client.Close()
+		w.clientMu.Lock()
+		w.closed.Store(true)
+		client := w.client
+		w.clientMu.Unlock()
+
+		w.connected.Store(false)
+		err := client.Close()
 		if err != nil {
 			w.closeErr = w.errFactory("Close", sdkerr.ErrWSClose, err)
 		}
+		w.publishState(ws.StateEvent{State: ws.StateClosed, Err: cause})
+
+		if cause == nil {
+			cause = ws.ErrClosed
+		}
+		w.life.Finish(cause)
 	})
 	return w.closeErr
 }
 
+// Done returns a channel that is closed once the client has shut down.
+func (w *WSMarket) Done() <-chan struct{} {
+	return w.life.Done()
+}
+
+// Err returns nil while the client is running. Once Done is closed it
+// returns ws.ErrClosed after Close, or the error that ended the client.
+func (w *WSMarket) Err() error {
+	return w.life.Err()
+}
+
+func (w *WSMarket) lifetimeContext() context.Context {
+	if w.lifetime == nil {
+		return context.Background()
+	}
+	return w.lifetime
+}
+
 // Subscribe registers a new subscription and waits for server acknowledgment.
 //
 // Returns a SubscriptionHandle used for safe unsubscription.
@@ -227,7 +340,7 @@ func (s *subscriptionWrapper) Unsubscribe(ctx context.Context) error {
 		writeDone := make(chan error, 1)
 
 		go func() {
-			writeDone <- s.ws.client.WriteMessage(msg)
+			writeDone <- s.ws.getClient().WriteMessage(msg)
 		}()
 
 		select {
@@ -245,6 +358,22 @@ func (s *subscriptionWrapper) Unsubscribe(ctx context.Context) error {
 	return s.err
 }
 
+// reject drops a subscription the server refused to restore and reports err
+// through its onInvalid callback. It does nothing after Unsubscribe.
+func (s *subscriptionWrapper) reject(err error) {
+	rejected := false
+	s.once.Do(func() {
+		s.ws.activeSubsMu.Lock()
+		delete(s.ws.activeSubs, s.inner.id())
+		s.ws.activeSubsMu.Unlock()
+		s.ws.router.Unregister(s.inner)
+		rejected = true
+	})
+	if rejected {
+		s.inner.reportInvalid(err)
+	}
+}
+
 type message struct {
 	Channel string          `json:"channel"`
 	Data    json.RawMessage `json:"data"`
@@ -261,11 +390,22 @@ func (w *WSMarket) startPinger(ctx context.Context) {
 			select {
 			case <-ctx.Done():
 				return
+			case <-w.life.Done():
+				return
 			case <-ticker.C:
-				if err := w.sendPing(); err != nil {
-					_ = w.Close()
+				if w.closed.Load() {
 					return
 				}
+				if !w.connected.Load() {
+					continue
+				}
+				if err := w.sendPing(); err != nil {
+					if w.reconnect == nil {
+						_ = w.Close()
+						return
+					}
+					w.dropConnection()
+				}
 			}
 		}
 	}()
@@ -339,7 +479,7 @@ func (w *WSMarket) sendAndAwaitResponse(
 		return w.errFactory("sendAndAwaitResponse", sdkerr.ErrWSWrite, err)
 	}
 
-	if err := w.client.WriteMessage(msg); err != nil {
+	if err := w.getClient().WriteMessage(msg); err != nil {
 		return w.errFactory("sendAndAwaitResponse", sdkerr.ErrWSWrite, err)
 	}
 
@@ -361,20 +501,32 @@ func (w *WSMarket) sendAndAwaitResponse(
 	return nil
 }
 
-func (w *WSMarket) readingMessage(ctx context.Context) {
+func (w *WSMarket) readingMessage(ctx context.Context, client ws.Client) {
 	go func() {
-		defer w.Close()
 		for {
 			select {
 			case <-ctx.Done():
+				_ = w.shutdown(ctx.Err())
 				return
 			default:
-				data, err := w.client.ReadMessage()
+				data, err := client.ReadMessage()
+				if err != nil && w.handleDrop(err) {
+					continue
+				}
 				if err != nil {
 					err = w.errFactory("readingMessage", sdkerr.ErrWSRead, err)
+					if w.releaseReader(client, err) {
+						return
+					}
+					w.connected.Store(false)
 					if w.onDisconnect != nil {
 						w.onDisconnect(err)
 					}
+					if w.reconnect == nil || w.closed.Load() || ctx.Err() != nil {
+						_ = w.shutdown(err)
+						return
+					}
+					w.reconnectLoop(ctx, client, err)
 					return
 				}
 				w.handleMessage(data)
@@ -446,3 +598,26 @@ func unzipPayload(data []byte) ([]byte, error) {
 
 	return out.Bytes(), nil
 }
+
+// Stats returns the buffer statistics of the current connection. It is the
+// zero value if the ws.Client does not implement ws.StatsReporter.
+func (w *WSMarket) Stats() ws.Stats {
+	if r, ok := w.getClient().(ws.StatsReporter); ok {
+		return r.Stats()
+	}
+	return ws.Stats{}
+}
+
+// handleDrop reports whether err signals dropped frames and handles it.
+func (w *WSMarket) handleDrop(err error) bool {
+	var dropErr *ws.DropError
+	if !errors.As(err, &dropErr) {
+		return false
+	}
+
+	if w.onFramesDropped != nil {
+		w.onFramesDropped(dropErr.Count)
+	}
+	w.router.Invalidate(err)
+	return true
+}
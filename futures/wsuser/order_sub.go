@@ -170,27 +170,27 @@ func (s *Order) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	side, err := parseOrderSide(tmp.Side)
+	side, err := ParseOrderSide(tmp.Side)
 	if err != nil {
 		return err
 	}
-	category, err := parseOrderCategory(tmp.Category)
+	category, err := ParseOrderCategory(tmp.Category)
 	if err != nil {
 		return err
 	}
-	orderType, err := parseOrderType(tmp.OrderType)
+	orderType, err := ParseOrderType(tmp.OrderType)
 	if err != nil {
 		return err
 	}
-	openType, err := parseOpenType(tmp.OpenType)
+	openType, err := ParseOpenType(tmp.OpenType)
 	if err != nil {
 		return err
 	}
-	state, err := parseOrderState(tmp.State)
+	state, err := ParseOrderState(tmp.State)
 	if err != nil {
 		return err
 	}
-	errorCode, err := parseOrderErrorCode(tmp.ErrorCode)
+	errorCode, err := ParseOrderErrorCode(tmp.ErrorCode)
 	if err != nil {
 		return err
 	}
@@ -248,7 +248,8 @@ type orderJSON struct {
 	UpdateTime   int64           `json:"updateTime"`
 }
 
-func parseOrderSide(code int) (OrderSide, error) {
+// ParseOrderSide maps a MEXC futures order side code to OrderSide.
+func ParseOrderSide(code int) (OrderSide, error) {
 	switch code {
 	case 1:
 		return OrderSideOpenLong, nil
@@ -263,7 +264,8 @@ func parseOrderSide(code int) (OrderSide, error) {
 	}
 }
 
-func parseOrderType(code int) (OrderType, error) {
+// ParseOrderType maps a MEXC futures order type code to OrderType.
+func ParseOrderType(code int) (OrderType, error) {
 	switch code {
 	case 1:
 		return OrderTypeLimit, nil
@@ -282,7 +284,8 @@ func parseOrderType(code int) (OrderType, error) {
 	}
 }
 
-func parseOrderCategory(code int) (OrderCategory, error) {
+// ParseOrderCategory maps a MEXC futures order category code to OrderCategory.
+func ParseOrderCategory(code int) (OrderCategory, error) {
 	switch code {
 	case 1:
 		return OrderCategoryLimitOrder, nil
@@ -299,7 +302,8 @@ func parseOrderCategory(code int) (OrderCategory, error) {
 
 
 
-func parseOrderState(code int) (OrderState, error) {
+// ParseOrderState maps a MEXC futures order state code to OrderState.
+func ParseOrderState(code int) (OrderState, error) {
 	switch code {
 	case 1:
 		return OrderStateUninformed, nil
@@ -341,7 +345,8 @@ var orderErrorCodeMap = map[int]OrderErrorCode{
 	21: OrderErrorCodeMarketCancel,
 }
 
-func parseOrderErrorCode(code int) (OrderErrorCode, error) {
+// ParseOrderErrorCode maps a MEXC futures order error code to OrderErrorCode.
+func ParseOrderErrorCode(code int) (OrderErrorCode, error) {
 	if val, ok := orderErrorCodeMap[code]; ok {
 		return val, nil
 	}
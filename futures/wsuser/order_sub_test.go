@@ -240,3 +240,12 @@ func TestOrder_UnmarshalJSON(t *testing.T) {
 		assert.ErrorContains(t, err, "unknown order error code: 99")
 	})
 }
+
+func TestParseOrderErrorCode(t *testing.T) {
+	code, err := ParseOrderErrorCode(21)
+	assert.NoError(t, err)
+	assert.Equal(t, OrderErrorCodeMarketCancel, code)
+
+	_, err = ParseOrderErrorCode(99)
+	assert.Error(t, err)
+}
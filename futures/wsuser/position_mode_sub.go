@@ -85,7 +85,7 @@ func (p *PositionModeEvent) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	mode, err := parsePositionMode(tmp.PositionMode)
+	mode, err := ParsePositionMode(tmp.PositionMode)
 	if err != nil {
 		return err
 	}
@@ -94,7 +94,8 @@ func (p *PositionModeEvent) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
-func parsePositionMode(code int) (PositionMode, error) {
+// ParsePositionMode maps a MEXC futures position mode code to PositionMode.
+func ParsePositionMode(code int) (PositionMode, error) {
 	switch code {
 	case 1:
 		return PositionModeHedge, nil
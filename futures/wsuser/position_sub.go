@@ -105,17 +105,17 @@ func (p *PositionEvent) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	positionType, err := parsePositionType(tmp.PositionType)
+	positionType, err := ParsePositionType(tmp.PositionType)
 	if err != nil {
 		return err
 	}
 
-	openType, err := parseOpenType(tmp.OpenType)
+	openType, err := ParseOpenType(tmp.OpenType)
 	if err != nil {
 		return err
 	}
 
-	state, err := parsePositionState(tmp.State)
+	state, err := ParsePositionState(tmp.State)
 	if err != nil {
 		return err
 	}
@@ -167,7 +167,8 @@ type positionJSON struct {
 
 
 
-func parsePositionState(code int) (PositionState, error) {
+// ParsePositionState maps a MEXC futures position state code to PositionState.
+func ParsePositionState(code int) (PositionState, error) {
 	switch code {
 	case 1:
 		return PositionStateHolding, nil
@@ -193,3 +193,24 @@ func TestPositionEvent_UnmarshalJSON(t *testing.T) {
 		assert.ErrorContains(t, err, "unknown position state code")
 	})
 }
+
+func TestParsePositionCodes(t *testing.T) {
+	positionType, err := ParsePositionType(2)
+	assert.NoError(t, err)
+	assert.Equal(t, PositionTypeShort, positionType)
+
+	openType, err := ParseOpenType(1)
+	assert.NoError(t, err)
+	assert.Equal(t, OpenTypeIsolated, openType)
+
+	state, err := ParsePositionState(3)
+	assert.NoError(t, err)
+	assert.Equal(t, PositionStateClosed, state)
+
+	_, err = ParsePositionType(0)
+	assert.Error(t, err)
+	_, err = ParseOpenType(3)
+	assert.Error(t, err)
+	_, err = ParsePositionState(4)
+	assert.Error(t, err)
+}
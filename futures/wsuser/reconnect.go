@@ -0,0 +1,128 @@
+package wsuser
+
+import (
+	"context"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+)
+
+func (w *WSUser) getClient() ws.Client {
+	w.clientMu.RLock()
+	defer w.clientMu.RUnlock()
+	return w.client
+}
+
+// swapClient installs client as the active connection and marks it pending
+// until settlePending. It returns false if the WSUser was closed in the
+// meantime.
+func (w *WSUser) swapClient(client ws.Client) bool {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	if w.closed.Load() {
+		return false
+	}
+	w.client = client
+	w.pending, w.pendingErr = client, nil
+	return true
+}
+
+// releaseReader reports whether the reader of client should stop without
+// starting a reconnect: either client was replaced, or reconnectLoop is still
+// logging in on it and takes over err.
+func (w *WSUser) releaseReader(client ws.Client, err error) bool {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	if client == w.pending {
+		w.pendingErr = err
+		return true
+	}
+	return client != w.client
+}
+
+// settlePending hands the pending connection over to its reader and returns
+// the error the reader reported during the login.
+func (w *WSUser) settlePending() error {
+	w.clientMu.Lock()
+	defer w.clientMu.Unlock()
+
+	err := w.pendingErr
+	w.pending, w.pendingErr = nil, nil
+	return err
+}
+
+func (w *WSUser) publishState(e ws.StateEvent) {
+	if w.onStateChange != nil {
+		w.onStateChange(e)
+	}
+}
+
+// dropConnection closes the active connection so that its reader starts a
+// reconnect.
+func (w *WSUser) dropConnection() {
+	w.connected.Store(false)
+	_ = w.getClient().Close()
+}
+
+// reconnectLoop replaces the failed connection old with a new one from the
+// factory and logs in again. Subscriptions are routed locally, so they keep
+// working once the login succeeds. A rejected login counts as a failed
+// attempt, so a revoked key ends in StateClosed with the login error.
+func (w *WSUser) reconnectLoop(ctx context.Context, old ws.Client, cause error) {
+	_ = old.Close()
+
+	for attempt := 1; ; attempt++ {
+		if w.reconnect.Exhausted(attempt) {
+			_ = w.shutdown(cause)
+			return
+		}
+
+		w.publishState(ws.StateEvent{State: ws.StateReconnecting, Attempt: attempt, Err: cause})
+
+		select {
+		case <-ctx.Done():
+			_ = w.shutdown(ctx.Err())
+			return
+		case <-time.After(w.reconnect.Delay(attempt)):
+		}
+
+		if w.closed.Load() {
+			return
+		}
+
+		w.publishState(ws.StateEvent{State: ws.StateConnecting, Attempt: attempt})
+
+		client := w.factory(defaultBaseURL)
+		if err := client.Connect(ctx); err != nil {
+			cause = w.errFactory("reconnect", sdkerr.ErrWSConnection, err)
+			continue
+		}
+
+		if !w.swapClient(client) {
+			_ = client.Close()
+			return
+		}
+
+		w.readingMessage(ctx, client)
+
+		err := w.login()
+		if err == nil {
+			err = w.settlePending()
+		}
+		if err != nil {
+			// The reader of a pending connection stops quietly, so a failed
+			// login counts as a failed attempt of this loop.
+			cause = err
+			_ = client.Close()
+			continue
+		}
+
+		w.connected.Store(true)
+		w.publishState(ws.StateEvent{State: ws.StateConnected, Attempt: attempt})
+		w.publishState(ws.StateEvent{State: ws.StateResynced, Attempt: attempt, Err: cause})
+		return
+	}
+}
@@ -0,0 +1,218 @@
+package wsuser
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// loginClient accepts every login request.
+func loginClient() *testutil.PipeClient {
+	c := testutil.NewPipeClient()
+	c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+		if strings.Contains(string(msg), `"login"`) {
+			c.Push([]byte(`{"channel":"rs.login","data":"success"}`))
+		}
+	}
+	return c
+}
+
+type stateRecorder struct {
+	mu     sync.Mutex
+	events []ws.StateEvent
+}
+
+func (r *stateRecorder) record(e ws.StateEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *stateRecorder) states() []ws.ConnState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []ws.ConnState
+	for _, e := range r.events {
+		out = append(out, e.State)
+	}
+	return out
+}
+
+func TestWSUser_Reconnect_LogsInAndResyncs(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		clients []*testutil.PipeClient
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		c := loginClient()
+		clients = append(clients, c)
+		return c
+	}
+	client := func(i int) *testutil.PipeClient {
+		mu.Lock()
+		defer mu.Unlock()
+		if i >= len(clients) {
+			return nil
+		}
+		return clients[i]
+	}
+
+	states := &stateRecorder{}
+	w := NewWSUserWithFactory("key", "secret", factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(states.record),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+
+	received := make(chan Asset, 1)
+	_, err := w.Subscribe(context.Background(), NewAssetSub(func(a Asset) {
+		received <- a
+	}))
+	require.NoError(t, err)
+
+	_ = client(0).Close()
+
+	require.Eventually(t, func() bool {
+		s := states.states()
+		return s[len(s)-1] == ws.StateResynced
+	}, time.Second, time.Millisecond)
+	assert.Contains(t, states.states(), ws.StateReconnecting)
+
+	require.Len(t, client(1).Writes(), 1)
+	assert.Contains(t, string(client(1).Writes()[0]), `"login"`)
+
+	client(1).Push([]byte(`{"channel":"push.personal.asset","ts":1,"data":{"currency":"USDT","availableBalance":10}}`))
+	select {
+	case a := <-received:
+		assert.Equal(t, "USDT", a.Currency)
+	case <-time.After(time.Second):
+		t.Fatal("subscription not routed after reconnect")
+	}
+
+	require.NoError(t, w.Close())
+	s := states.states()
+	assert.Equal(t, ws.StateClosed, s[len(s)-1])
+}
+
+func TestWSUser_Reconnect_RetriesFailedLogin(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 2 {
+			c := testutil.NewPipeClient()
+			c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+				c.Push([]byte(`{"channel":"rs.error","data":"signature expired"}`))
+			}
+			return c
+		}
+		return loginClient()
+	}
+
+	resynced := make(chan struct{}, 1)
+	w := NewWSUserWithFactory("key", "secret", factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateResynced {
+				resynced <- struct{}{}
+			}
+		}),
+	)
+	w.internalTimeout = 100 * time.Millisecond
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = w.getClient().Close()
+
+	select {
+	case <-resynced:
+	case <-time.After(time.Second):
+		t.Fatal("client did not resync")
+	}
+
+	mu.Lock()
+	assert.Equal(t, 3, calls)
+	mu.Unlock()
+	require.NoError(t, w.Close())
+}
+
+func TestWSUser_NoReconnect_ClosesOnReadError(t *testing.T) {
+	client := loginClient()
+	closed := make(chan struct{})
+	w := NewWSUserWithFactory("key", "secret", func(string) ws.Client { return client },
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateClosed {
+				close(closed)
+			}
+		}),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = client.Close()
+
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Fatal("client not closed")
+	}
+}
+
+func TestWSUser_Reconnect_GivesUpOnRejectedLogin(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return loginClient()
+		}
+		c := testutil.NewPipeClient()
+		c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+			c.Push([]byte(`{"channel":"rs.error","data":"api key revoked"}`))
+		}
+		return c
+	}
+
+	closed := make(chan ws.StateEvent, 1)
+	w := NewWSUserWithFactory("key", "secret", factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond, MaxAttempts: 2}),
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateClosed {
+				closed <- e
+			}
+		}),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = w.getClient().Close()
+
+	select {
+	case e := <-closed:
+		assert.ErrorContains(t, e.Err, "api key revoked")
+	case <-time.After(time.Second):
+		t.Fatal("client did not give up")
+	}
+	<-w.Done()
+	assert.ErrorContains(t, w.Err(), "api key revoked")
+
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, 3, calls)
+	mu.Unlock()
+}
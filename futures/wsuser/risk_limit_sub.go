@@ -92,7 +92,7 @@ func (r *RiskLimitEvent) UnmarshalJSON(data []byte) error {
 		return err
 	}
 
-	positionType, err := parsePositionType(tmp.PositionType)
+	positionType, err := ParsePositionType(tmp.PositionType)
 	if err != nil {
 		return err
 	}
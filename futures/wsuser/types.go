@@ -10,7 +10,8 @@ const (
 	PositionTypeShort PositionType = "SHORT"
 )
 
-func parsePositionType(code int) (PositionType, error) {
+// ParsePositionType maps a MEXC futures position type code to PositionType.
+func ParsePositionType(code int) (PositionType, error) {
 	switch code {
 	case 1:
 		return PositionTypeLong, nil
@@ -29,7 +30,8 @@ const (
 	OpenTypeCross    OpenType = "CROSS"
 )
 
-func parseOpenType(code int) (OpenType, error) {
+// ParseOpenType maps a MEXC futures open type code to OpenType.
+func ParseOpenType(code int) (OpenType, error) {
 	switch code {
 	case 1:
 		return OpenTypeIsolated, nil
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strconv"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
@@ -49,6 +50,11 @@ type SubscriptionHandle interface {
 // WSUser is a WebSocket client for MEXC FUTURES user streams.
 type WSUser struct {
 	client         ws.Client
+	clientMu       sync.RWMutex
+	pending        ws.Client // connection being restored by reconnectLoop
+	pendingErr     error
+	factory        func(url string) ws.Client
+	clientOpts     []ws.Option
 	apiKey         string
 	secretKey      string
 	waitingTimeout time.Duration
@@ -58,6 +64,11 @@ type WSUser struct {
 	now             func() time.Time
 	onDisconnect    func(err error)
 	onLatency       func(latency time.Duration)
+	onFramesDropped func(n uint64)
+	onStateChange   func(ws.StateEvent)
+	reconnect       *ws.Backoff
+	connected       atomic.Bool
+	closed          atomic.Bool
 
 	activeSubs   map[string]SubscriptionHandle
 	activeSubsMu sync.Mutex
@@ -67,6 +78,8 @@ type WSUser struct {
 	promiseFunc func(matchFn func(*message) (bool, error)) wsutil.Promise[message]
 
 	router    handlerRouter
+	lifetime  context.Context
+	life      wsutil.Lifecycle
 	closeOnce sync.Once
 	closeErr  error
 }
@@ -81,10 +94,7 @@ func NewWSUser(apiKey, secretKey string, opts ...Options) *WSUser {
 	if secretKey == "" {
 		panic("NewWSUser: secretKey is required")
 	}
-	factory := func(url string) ws.Client {
-		return ws.NewClient(url)
-	}
-	return NewWSUserWithFactory(apiKey, secretKey, factory, opts...)
+	return newWSUser(apiKey, secretKey, nil, opts...)
 }
 
 // NewWSUserWithFactory is like NewWSUser but uses the provided ws.Client factory.
@@ -100,9 +110,12 @@ func NewWSUserWithFactory(apiKey, secretKey string, factory func(url string) ws.
 	if factory == nil {
 		panic("NewWSUserWithFactory: factory must not be nil")
 	}
+	return newWSUser(apiKey, secretKey, factory, opts...)
+}
 
+func newWSUser(apiKey, secretKey string, factory func(url string) ws.Client, opts ...Options) *WSUser {
 	w := &WSUser{
-		client:         factory(defaultBaseURL),
+		factory:        factory,
 		apiKey:         apiKey,
 		secretKey:      secretKey,
 		waitingTimeout: 1 * time.Second,
@@ -121,9 +134,25 @@ func NewWSUserWithFactory(apiKey, secretKey string, factory func(url string) ws.
 		opt(w)
 	}
 
+	if w.factory == nil {
+		w.factory = func(url string) ws.Client {
+			return ws.NewClient(url, w.clientOpts...)
+		}
+	}
+	w.client = w.factory(defaultBaseURL)
+
 	return w
 }
 
+// WithClientOptions sets the ws.Options (proxy, TLS, headers, limits, ...)
+// used to create connections. They are ignored when the WSUser was created
+// with NewWSUserWithFactory.
+func WithClientOptions(opts ...ws.Option) Options {
+	return func(w *WSUser) {
+		w.clientOpts = opts
+	}
+}
+
 // WithOnDisconnect registers a callback for unexpected disconnections.
 func WithOnDisconnect(f func(err error)) Options {
 	return func(w *WSUser) {
@@ -138,33 +167,115 @@ func WithPingLatencyHandler(f func(time.Duration)) Options {
 	}
 }
 
+// WithOnFramesDropped registers a callback for frames dropped by the ws.Client
+// backpressure policy (see ws.WithBackpressure). Updates in those frames are
+// lost and account state should be refreshed over REST.
+func WithOnFramesDropped(f func(n uint64)) Options {
+	return func(w *WSUser) {
+		w.onFramesDropped = f
+	}
+}
+
+// WithReconnect enables automatic reconnection after the connection drops.
+// Reconnect attempts are spaced by b. Once reconnected, the client logs in
+// again with a fresh signature, existing SubscriptionHandles stay valid and
+// a ws.StateResynced event is published, since updates sent during the gap
+// are lost.
+func WithReconnect(b ws.Backoff) Options {
+	return func(w *WSUser) {
+		w.reconnect = &b
+	}
+}
+
+// WithOnStateChange registers a callback for connection state changes.
+func WithOnStateChange(f func(ws.StateEvent)) Options {
+	return func(w *WSUser) {
+		w.onStateChange = f
+	}
+}
+
+// WithLifetime binds the connection to ctx: once ctx is done the client is
+// closed. By default it runs until Close is called.
+func WithLifetime(ctx context.Context) Options {
+	return func(w *WSUser) {
+		w.lifetime = ctx
+	}
+}
+
 // Connect opens the WebSocket connection, authenticates, and starts internal workers.
+//
+// ctx only bounds the dial. The connection runs until Close is called, it
+// fails for good or the WithLifetime context is done; see Done and Err.
 func (w *WSUser) Connect(ctx context.Context) error {
-	err := w.client.Connect(ctx)
+	w.publishState(ws.StateEvent{State: ws.StateConnecting})
+
+	client := w.getClient()
+	err := client.Connect(ctx)
 	if err != nil {
 		return w.errFactory("Connect", sdkerr.ErrWSConnection, err)
 	}
-	w.readingMessage(ctx)
-	w.startPinger(ctx)
+	w.connected.Store(true)
+	lifetime := w.lifetimeContext()
+	w.readingMessage(lifetime, client)
+	w.startPinger(lifetime)
+	context.AfterFunc(lifetime, func() {
+		_ = w.shutdown(lifetime.Err())
+	})
 
 	if err := w.login(); err != nil {
-		w.Close()
+		_ = w.shutdown(err)
 		return err
 	}
+
+	w.publishState(ws.StateEvent{State: ws.StateConnected})
 	return nil
 }
 
 // Close shuts down the connection and internal workers. Safe to call multiple times.
 func (w *WSUser) Close() error {
+	return w.shutdown(nil)
+}
+
+func (w *WSUser) shutdown(cause error) error {
 	w.closeOnce.Do(func() {
-		err := w.client.Close()
+		w.clientMu.Lock()
+		w.closed.Store(true)
+		client := w.client
+		w.clientMu.Unlock()
+
+		w.connected.Store(false)
+		err := client.Close()
 		if err != nil {
 			w.closeErr = w.errFactory("Close", sdkerr.ErrWSClose, err)
 		}
+		w.publishState(ws.StateEvent{State: ws.StateClosed, Err: cause})
+
+		if cause == nil {
+			cause = ws.ErrClosed
+		}
+		w.life.Finish(cause)
 	})
 	return w.closeErr
 }
 
+// Done returns a channel that is closed once the client has shut down.
+func (w *WSUser) Done() <-chan struct{} {
+	return w.life.Done()
+}
+
+// Err returns nil while the client is running. Once Done is closed it
+// returns ws.ErrClosed after Close, or the error that ended the client.
+func (w *WSUser) Err() error {
+	return w.life.Err()
+}
+
+func (w *WSUser) lifetimeContext() context.Context {
+	if w.lifetime == nil {
+		return context.Background()
+	}
+	return w.lifetime
+}
+
 // Subscribe registers a new subscription.
 //
 // Returns a SubscriptionHandle used for safe unsubscription.
@@ -309,11 +420,22 @@ func (w *WSUser) startPinger(ctx context.Context) {
 			select {
 			case <-ctx.Done():
 				return
+			case <-w.life.Done():
+				return
 			case <-ticker.C:
-				if err := w.sendPing(); err != nil {
-					_ = w.Close()
+				if w.closed.Load() {
 					return
 				}
+				if !w.connected.Load() {
+					continue
+				}
+				if err := w.sendPing(); err != nil {
+					if w.reconnect == nil {
+						_ = w.Close()
+						return
+					}
+					w.dropConnection()
+				}
 			}
 		}
 	}()
@@ -387,7 +509,7 @@ func (w *WSUser) sendAndAwaitResponse(
 		return w.errFactory("sendAndAwaitResponse", sdkerr.ErrWSWrite, err)
 	}
 
-	if err := w.client.WriteMessage(msg); err != nil {
+	if err := w.getClient().WriteMessage(msg); err != nil {
 		return w.errFactory("sendAndAwaitResponse", sdkerr.ErrWSWrite, err)
 	}
 
@@ -409,20 +531,32 @@ func (w *WSUser) sendAndAwaitResponse(
 	return nil
 }
 
-func (w *WSUser) readingMessage(ctx context.Context) {
+func (w *WSUser) readingMessage(ctx context.Context, client ws.Client) {
 	go func() {
-		defer w.Close()
 		for {
 			select {
 			case <-ctx.Done():
+				_ = w.shutdown(ctx.Err())
 				return
 			default:
-				data, err := w.client.ReadMessage()
+				data, err := client.ReadMessage()
+				if err != nil && w.handleDrop(err) {
+					continue
+				}
 				if err != nil {
 					err = w.errFactory("readingMessage", sdkerr.ErrWSRead, err)
+					if w.releaseReader(client, err) {
+						return
+					}
+					w.connected.Store(false)
 					if w.onDisconnect != nil {
 						w.onDisconnect(err)
 					}
+					if w.reconnect == nil || w.closed.Load() || ctx.Err() != nil {
+						_ = w.shutdown(err)
+						return
+					}
+					w.reconnectLoop(ctx, client, err)
 					return
 				}
 				w.handleMessage(data)
@@ -454,3 +588,25 @@ func (w *WSUser) handleMessage(data []byte) {
 
 	w.router.Route(msg)
 }
+
+// Stats returns the buffer statistics of the current connection. It is the
+// zero value if the ws.Client does not implement ws.StatsReporter.
+func (w *WSUser) Stats() ws.Stats {
+	if r, ok := w.getClient().(ws.StatsReporter); ok {
+		return r.Stats()
+	}
+	return ws.Stats{}
+}
+
+// handleDrop reports whether err signals dropped frames and handles it.
+func (w *WSUser) handleDrop(err error) bool {
+	var dropErr *ws.DropError
+	if !errors.As(err, &dropErr) {
+		return false
+	}
+
+	if w.onFramesDropped != nil {
+		w.onFramesDropped(dropErr.Count)
+	}
+	return true
+}
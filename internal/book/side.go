@@ -0,0 +1,117 @@
+package book
+
+import (
+	"sort"
+
+	"github.com/shopspring/decimal"
+)
+
+// Level is a single price level of one side of an order book.
+type Level struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// Side keeps the price levels of one side of an order book sorted from the
+// best price outwards. It is not safe for concurrent use.
+type Side struct {
+	levels []Level
+	better func(a, b decimal.Decimal) bool
+}
+
+// NewBids creates a side ordered by descending price.
+func NewBids() *Side {
+	return &Side{better: func(a, b decimal.Decimal) bool { return a.GreaterThan(b) }}
+}
+
+// NewAsks creates a side ordered by ascending price.
+func NewAsks() *Side {
+	return &Side{better: func(a, b decimal.Decimal) bool { return a.LessThan(b) }}
+}
+
+// search returns the index of price and whether it is present.
+func (s *Side) search(price decimal.Decimal) (int, bool) {
+	i := sort.Search(len(s.levels), func(i int) bool {
+		return !s.better(s.levels[i].Price, price)
+	})
+	return i, i < len(s.levels) && s.levels[i].Price.Equal(price)
+}
+
+// Set replaces the quantity at price. A zero or negative quantity removes
+// the level.
+func (s *Side) Set(price, quantity decimal.Decimal) {
+	i, found := s.search(price)
+
+	if !quantity.IsPositive() {
+		if found {
+			s.levels = append(s.levels[:i], s.levels[i+1:]...)
+		}
+		return
+	}
+
+	if found {
+		s.levels[i].Quantity = quantity
+		return
+	}
+
+	s.levels = append(s.levels, Level{})
+	copy(s.levels[i+1:], s.levels[i:])
+	s.levels[i] = Level{Price: price, Quantity: quantity}
+}
+
+// Reset removes all levels.
+func (s *Side) Reset() {
+	s.levels = s.levels[:0]
+}
+
+// Len returns the number of levels.
+func (s *Side) Len() int {
+	return len(s.levels)
+}
+
+// Best returns the best level, if any.
+func (s *Side) Best() (Level, bool) {
+	if len(s.levels) == 0 {
+		return Level{}, false
+	}
+	return s.levels[0], true
+}
+
+// Top returns a copy of the best n levels. n <= 0 returns every level.
+func (s *Side) Top(n int) []Level {
+	if n <= 0 || n > len(s.levels) {
+		n = len(s.levels)
+	}
+	out := make([]Level, n)
+	copy(out, s.levels[:n])
+	return out
+}
+
+// QuantityAt returns the quantity resting at exactly price.
+func (s *Side) QuantityAt(price decimal.Decimal) decimal.Decimal {
+	if i, found := s.search(price); found {
+		return s.levels[i].Quantity
+	}
+	return decimal.Zero
+}
+
+// EachTo calls f for each level priced at or better than price, from the
+// best price outwards.
+func (s *Side) EachTo(price decimal.Decimal, f func(Level)) {
+	for _, l := range s.levels {
+		if s.better(price, l.Price) {
+			return
+		}
+		f(l)
+	}
+}
+
+// DepthTo returns the total quantity of all levels priced at or better than
+// price.
+func (s *Side) DepthTo(price decimal.Decimal) decimal.Decimal {
+	total := decimal.Zero
+	s.EachTo(price, func(l Level) {
+		total = total.Add(l.Quantity)
+	})
+	return total
+}
@@ -0,0 +1,67 @@
+package book
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func TestSide_Ordering(t *testing.T) {
+	bids := NewBids()
+	bids.Set(d("100"), d("1"))
+	bids.Set(d("102"), d("2"))
+	bids.Set(d("101"), d("3"))
+
+	top := bids.Top(0)
+	require.Len(t, top, 3)
+	assert.True(t, top[0].Price.Equal(d("102")))
+	assert.True(t, top[2].Price.Equal(d("100")))
+
+	asks := NewAsks()
+	asks.Set(d("105"), d("1"))
+	asks.Set(d("103"), d("2"))
+
+	best, ok := asks.Best()
+	require.True(t, ok)
+	assert.True(t, best.Price.Equal(d("103")))
+}
+
+func TestSide_SetUpdatesAndDeletes(t *testing.T) {
+	s := NewAsks()
+	s.Set(d("1.10"), d("5"))
+	s.Set(d("1.1"), d("7"))
+	assert.Equal(t, 1, s.Len())
+	assert.True(t, s.QuantityAt(d("1.100")).Equal(d("7")))
+
+	s.Set(d("1.1"), decimal.Zero)
+	assert.Equal(t, 0, s.Len())
+
+	s.Set(d("2"), decimal.Zero)
+	assert.Equal(t, 0, s.Len())
+
+	_, ok := s.Best()
+	assert.False(t, ok)
+}
+
+func TestSide_TopAndDepthTo(t *testing.T) {
+	s := NewBids()
+	s.Set(d("100"), d("1"))
+	s.Set(d("99"), d("2"))
+	s.Set(d("98"), d("4"))
+
+	assert.Len(t, s.Top(2), 2)
+	assert.Len(t, s.Top(10), 3)
+
+	assert.True(t, s.DepthTo(d("99")).Equal(d("3")))
+	assert.True(t, s.DepthTo(d("101")).IsZero())
+	assert.True(t, s.DepthTo(d("1")).Equal(d("7")))
+
+	s.Reset()
+	assert.Equal(t, 0, s.Len())
+}
@@ -0,0 +1,314 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+const maxBufferedUpdates = 1000
+
+var (
+	// ErrInvalidUpdate is reported through the resync callback when the
+	// stream delivers an update that cannot be decoded.
+	ErrInvalidUpdate = errors.New("invalid order book update")
+
+	// ErrAlreadyStarted is returned when Start is called more than once.
+	ErrAlreadyStarted = errors.New("order book already started")
+
+	// ErrClosed is returned by Start when Close is called before Start
+	// completes.
+	ErrClosed = errors.New("order book closed")
+)
+
+// Handle cancels a stream subscription.
+type Handle interface {
+	Unsubscribe(ctx context.Context) error
+}
+
+// Feed is the product specific part of a Syncer: where updates and
+// snapshots come from and how they are sequenced. Load and Apply are called
+// with the syncer locked.
+type Feed[U, S any] interface {
+	// Subscribe starts streaming updates to onUpdate and decode failures to
+	// onInvalid.
+	Subscribe(ctx context.Context, onUpdate func(U), onInvalid func(error)) (Handle, error)
+	// Snapshot loads a full snapshot of the book.
+	Snapshot(ctx context.Context) (S, error)
+	// Load fills the emptied bids and asks from snap.
+	Load(snap S, bids, asks *Side)
+	// Apply applies u to bids and asks if it continues the book. It returns
+	// false without an error for updates the book already covers, and an
+	// error if u leaves a gap.
+	Apply(u U, bids, asks *Side) (bool, error)
+}
+
+// Config configures a Syncer.
+type Config struct {
+	// RetryDelay is how long to wait before loading another snapshot when
+	// the previous attempt failed or was older than the buffered updates.
+	RetryDelay time.Duration
+	// OnUpdate is called after the book changes, without the lock held.
+	OnUpdate func()
+	// OnResync is called when the book drops out of sync and starts loading
+	// a new snapshot.
+	OnResync func(reason error)
+	// OnError is called when a snapshot request fails.
+	OnError func(error)
+}
+
+// Syncer keeps a pair of sides in sync with an exchange. It buffers stream
+// updates while a snapshot loads, replays the buffer on top of the snapshot
+// and loads a new snapshot whenever Feed.Apply reports a gap. All methods
+// are safe for concurrent use.
+type Syncer[U, S any] struct {
+	feed Feed[U, S]
+	cfg  Config
+
+	mu      sync.RWMutex
+	bids    *Side
+	asks    *Side
+	ready   bool
+	syncing bool
+	started bool
+	closed  bool
+	buffer  []U
+
+	handle Handle
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewSyncer creates a Syncer for feed. Call Start to begin syncing.
+func NewSyncer[U, S any](feed Feed[U, S], cfg Config) *Syncer[U, S] {
+	return &Syncer[U, S]{
+		feed: feed,
+		cfg:  cfg,
+		bids: NewBids(),
+		asks: NewAsks(),
+	}
+}
+
+// Bids returns the bid side. Read it only inside View.
+func (s *Syncer[U, S]) Bids() *Side {
+	return s.bids
+}
+
+// Asks returns the ask side. Read it only inside View.
+func (s *Syncer[U, S]) Asks() *Side {
+	return s.asks
+}
+
+// Ready reports whether the book is in sync with the exchange.
+func (s *Syncer[U, S]) Ready() bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.ready
+}
+
+// View calls f with the book read-locked if it is Ready and reports whether
+// f was called.
+func (s *Syncer[U, S]) View(f func()) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if !s.ready {
+		return false
+	}
+	f()
+	return true
+}
+
+// Start subscribes through the feed and loads the first snapshot in the
+// background.
+func (s *Syncer[U, S]) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.started {
+		s.mu.Unlock()
+		return ErrAlreadyStarted
+	}
+	s.started = true
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.mu.Unlock()
+
+	if err := s.start(ctx); err != nil {
+		s.mu.Lock()
+		if !s.closed {
+			s.started = false
+		}
+		s.cancel()
+		s.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+func (s *Syncer[U, S]) start(ctx context.Context) error {
+	handle, err := s.feed.Subscribe(ctx, s.HandleUpdate, s.HandleInvalid)
+	if err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		// Close ran while subscribing and could not see the handle.
+		_ = handle.Unsubscribe(ctx)
+		return ErrClosed
+	}
+	s.handle = handle
+	s.resyncLocked()
+	s.mu.Unlock()
+	return nil
+}
+
+// Close unsubscribes from the stream and stops any pending snapshot load.
+// Safe to call multiple times.
+func (s *Syncer[U, S]) Close(ctx context.Context) error {
+	s.mu.Lock()
+	if !s.started || s.closed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.closed = true
+	s.ready = false
+	s.cancel()
+	handle := s.handle
+	s.mu.Unlock()
+
+	s.wg.Wait()
+
+	if handle == nil {
+		return nil
+	}
+	return handle.Unsubscribe(ctx)
+}
+
+// HandleUpdate applies u, or buffers it while a snapshot loads.
+func (s *Syncer[U, S]) HandleUpdate(u U) {
+	s.mu.Lock()
+
+	if s.closed {
+		s.mu.Unlock()
+		return
+	}
+
+	if !s.ready {
+		s.bufferLocked(u)
+		s.mu.Unlock()
+		return
+	}
+
+	applied, err := s.feed.Apply(u, s.bids, s.asks)
+	if err != nil {
+		s.ready = false
+		s.buffer = []U{u}
+		s.resyncLocked()
+		s.mu.Unlock()
+
+		if s.cfg.OnResync != nil {
+			s.cfg.OnResync(err)
+		}
+		return
+	}
+	s.mu.Unlock()
+
+	if applied && s.cfg.OnUpdate != nil {
+		s.cfg.OnUpdate()
+	}
+}
+
+// HandleInvalid drops the book out of sync after the stream failed to
+// deliver an update.
+func (s *Syncer[U, S]) HandleInvalid(err error) {
+	s.mu.Lock()
+
+	if s.closed || !s.ready {
+		s.mu.Unlock()
+		return
+	}
+
+	s.ready = false
+	s.buffer = nil
+	s.resyncLocked()
+	s.mu.Unlock()
+
+	if s.cfg.OnResync != nil {
+		s.cfg.OnResync(fmt.Errorf("%w: %v", ErrInvalidUpdate, err))
+	}
+}
+
+func (s *Syncer[U, S]) bufferLocked(u U) {
+	if len(s.buffer) >= maxBufferedUpdates {
+		s.buffer = s.buffer[1:]
+	}
+	s.buffer = append(s.buffer, u)
+}
+
+// resyncLocked starts loading a snapshot unless a load is already running.
+func (s *Syncer[U, S]) resyncLocked() {
+	if s.syncing || s.closed {
+		return
+	}
+	s.syncing = true
+	s.wg.Add(1)
+	go s.syncLoop()
+}
+
+func (s *Syncer[U, S]) syncLoop() {
+	defer s.wg.Done()
+
+	for {
+		snap, err := s.feed.Snapshot(s.ctx)
+		if err != nil {
+			if s.ctx.Err() != nil {
+				return
+			}
+			if s.cfg.OnError != nil {
+				s.cfg.OnError(err)
+			}
+		} else if s.loadSnapshot(snap) {
+			if s.cfg.OnUpdate != nil {
+				s.cfg.OnUpdate()
+			}
+			return
+		}
+
+		select {
+		case <-s.ctx.Done():
+			return
+		case <-time.After(s.cfg.RetryDelay):
+		}
+	}
+}
+
+// loadSnapshot replaces the book with snap and replays the buffered updates.
+// It returns false if the buffer does not continue from the snapshot, in
+// which case the remaining buffer is kept for the next attempt.
+func (s *Syncer[U, S]) loadSnapshot(snap S) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return false
+	}
+
+	s.bids.Reset()
+	s.asks.Reset()
+	s.feed.Load(snap, s.bids, s.asks)
+
+	for i, u := range s.buffer {
+		if _, err := s.feed.Apply(u, s.bids, s.asks); err != nil {
+			s.buffer = s.buffer[i:]
+			return false
+		}
+	}
+
+	s.buffer = nil
+	s.ready = true
+	s.syncing = false
+	return true
+}
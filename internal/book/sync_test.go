@@ -0,0 +1,131 @@
+package book
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errGap = errors.New("gap")
+
+type fakeHandle struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (h *fakeHandle) Unsubscribe(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	return nil
+}
+
+// seqFeed sequences updates by consecutive integers; each update sets a bid
+// at its own price. Snapshots are served from snaps in order.
+type seqFeed struct {
+	handle *fakeHandle
+	snaps  chan int
+	subErr error
+
+	version int
+}
+
+func (f *seqFeed) Subscribe(ctx context.Context, onUpdate func(int), onInvalid func(error)) (Handle, error) {
+	if f.subErr != nil {
+		return nil, f.subErr
+	}
+	return f.handle, nil
+}
+
+func (f *seqFeed) Snapshot(ctx context.Context) (int, error) {
+	select {
+	case v := <-f.snaps:
+		return v, nil
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+func (f *seqFeed) Load(snap int, bids, asks *Side) {
+	f.version = snap
+}
+
+func (f *seqFeed) Apply(u int, bids, asks *Side) (bool, error) {
+	if u <= f.version {
+		return false, nil
+	}
+	if u != f.version+1 {
+		return false, errGap
+	}
+	bids.Set(decimal.NewFromInt(int64(u)), d("1"))
+	f.version = u
+	return true, nil
+}
+
+func newSeqSyncer(cfg Config) (*Syncer[int, int], *seqFeed) {
+	f := &seqFeed{handle: &fakeHandle{}, snaps: make(chan int, 10)}
+	cfg.RetryDelay = time.Millisecond
+	return NewSyncer[int, int](f, cfg), f
+}
+
+func TestSyncer_ReplaysBufferAndResyncsOnGap(t *testing.T) {
+	resyncs := make(chan error, 1)
+	s, f := newSeqSyncer(Config{OnResync: func(reason error) { resyncs <- reason }})
+
+	s.HandleUpdate(9)
+	s.HandleUpdate(11)
+	f.snaps <- 10
+	require.NoError(t, s.Start(context.Background()))
+	require.Eventually(t, s.Ready, time.Second, time.Millisecond)
+	assert.Equal(t, 11, f.version)
+
+	s.HandleUpdate(13)
+	assert.ErrorIs(t, <-resyncs, errGap)
+	assert.False(t, s.Ready())
+	assert.False(t, s.View(func() {}))
+
+	f.snaps <- 12
+	require.Eventually(t, s.Ready, time.Second, time.Millisecond)
+	assert.Equal(t, 13, f.version)
+
+	// The second snapshot replaced the levels applied before the gap.
+	var levels []Level
+	assert.True(t, s.View(func() { levels = s.Bids().Top(0) }))
+	require.Len(t, levels, 1)
+	assert.True(t, levels[0].Price.Equal(d("13")))
+	require.NoError(t, s.Close(context.Background()))
+	assert.Equal(t, 1, f.handle.calls)
+}
+
+func TestSyncer_BufferKeepsNewestUpdates(t *testing.T) {
+	s, f := newSeqSyncer(Config{})
+
+	for u := 1; u <= maxBufferedUpdates+5; u++ {
+		s.HandleUpdate(u)
+	}
+	f.snaps <- 5
+	require.NoError(t, s.Start(context.Background()))
+	require.Eventually(t, s.Ready, time.Second, time.Millisecond)
+	assert.Equal(t, maxBufferedUpdates+5, f.version)
+	require.NoError(t, s.Close(context.Background()))
+}
+
+func TestSyncer_StartAfterSubscribeError(t *testing.T) {
+	s, f := newSeqSyncer(Config{})
+	f.subErr = errors.New("subscribe failed")
+
+	assert.Error(t, s.Start(context.Background()))
+
+	f.subErr = nil
+	require.NoError(t, s.Start(context.Background()))
+	assert.ErrorIs(t, s.Start(context.Background()), ErrAlreadyStarted)
+	require.NoError(t, s.Close(context.Background()))
+	require.NoError(t, s.Close(context.Background()))
+	assert.Equal(t, 1, f.handle.calls)
+}
@@ -0,0 +1,59 @@
+package spotapi
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// RequestBuilder builds requests for the spot REST API and sets the
+// X-MEXC-APIKEY header when the request needs it.
+type RequestBuilder struct {
+	inner  *httpx.RequestBuilder
+	apiKey string
+}
+
+func NewRequestBuilder(baseURL, apiKey string) *RequestBuilder {
+	return &RequestBuilder{
+		inner:  httpx.NewRequestBuilder(baseURL),
+		apiKey: apiKey,
+	}
+}
+
+func (s *RequestBuilder) WithMethod(method string) *RequestBuilder {
+	s.inner = s.inner.WithMethod(method)
+	return s
+}
+
+func (s *RequestBuilder) WithPath(path string) *RequestBuilder {
+	s.inner = s.inner.WithPath(path)
+	return s
+}
+
+func (s *RequestBuilder) WithQuery(query url.Values) *RequestBuilder {
+	s.inner = s.inner.WithQuery(query)
+	return s
+}
+
+func (s *RequestBuilder) WithBody(body []byte) *RequestBuilder {
+	s.inner = s.inner.WithBody(bytes.NewReader(body))
+	return s
+}
+
+func (s *RequestBuilder) Build() *transport.Request {
+	if s.inner.Method != http.MethodGet || s.apiKey != "" {
+		headers := buildHeaders(s.apiKey)
+		s.inner.WithHeaders(headers)
+	}
+	return s.inner.Build()
+}
+
+func buildHeaders(apiKey string) http.Header {
+	h := make(http.Header)
+	h.Set("X-MEXC-APIKEY", apiKey)
+	h.Set("Content-Type", "application/json")
+	return h
+}
@@ -0,0 +1,42 @@
+// Package spotapi holds the request and response plumbing shared by the
+// spot/wallet and spot/subaccount packages.
+package spotapi
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+)
+
+type responseErr struct {
+	Code    int    `json:"code"`
+	Message string `json:"msg"`
+}
+
+// CheckResponseError maps a non-2xx response to a spot error code.
+func CheckResponseError(status int, body []byte) error {
+	if status >= 200 && status < 300 {
+		return nil
+	}
+	var respErr responseErr
+	if err := json.Unmarshal(body, &respErr); err != nil {
+		return fmt.Errorf("http status %d: %s", status, string(body))
+	}
+	return errs.ErrorCode(respErr.Code)
+}
+
+// DecodeResponse decodes data into T and wraps failures as decode errors
+// of subsys.
+func DecodeResponse[T any](data []byte, subsys, op string) (*T, error) {
+	var result T
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrDecodeError).
+			WithCause(err)
+	}
+	return &result, nil
+}
@@ -1,6 +1,13 @@
 package testutil
 
-import "context"
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/wsutil"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+)
 
 type MockClient struct {
 	ConnectErr error
@@ -8,6 +15,8 @@ type MockClient struct {
 	Closed     bool
 	ReadFunc   func() ([]byte, error)
 	WriteFunc  func(msg []byte) error
+
+	life wsutil.Lifecycle
 }
 
 func (m *MockClient) Connect(ctx context.Context) error {
@@ -16,9 +25,18 @@ func (m *MockClient) Connect(ctx context.Context) error {
 
 func (m *MockClient) Close() error {
 	m.Closed = true
+	m.life.Finish(ws.ErrClosed)
 	return m.CloseErr
 }
 
+func (m *MockClient) Done() <-chan struct{} {
+	return m.life.Done()
+}
+
+func (m *MockClient) Err() error {
+	return m.life.Err()
+}
+
 func (m *MockClient) ReadMessage() ([]byte, error) {
 	if nil == m.ReadFunc {
 		return nil, nil
@@ -32,3 +50,94 @@ func (m *MockClient) WriteMessage(msg []byte) error {
 	}
 	return m.WriteFunc(msg)
 }
+
+// ErrPipeClosed is returned by PipeClient once it has been closed.
+var ErrPipeClosed = errors.New("pipe client closed")
+
+// PipeClient is a ws.Client backed by channels. Messages passed to Push are
+// returned by ReadMessage, which fails once the client is closed.
+type PipeClient struct {
+	ConnectErr error
+	// OnWrite, if set, is called for every written message. It may Push a
+	// reply.
+	OnWrite func(c *PipeClient, msg []byte)
+
+	in     chan []byte
+	done   chan struct{}
+	once   sync.Once
+	mu     sync.Mutex
+	writes [][]byte
+	life   wsutil.Lifecycle
+}
+
+func NewPipeClient() *PipeClient {
+	return &PipeClient{
+		in:   make(chan []byte, 100),
+		done: make(chan struct{}),
+	}
+}
+
+func (c *PipeClient) Connect(ctx context.Context) error {
+	return c.ConnectErr
+}
+
+func (c *PipeClient) ReadMessage() ([]byte, error) {
+	select {
+	case msg := <-c.in:
+		return msg, nil
+	case <-c.done:
+		return nil, ErrPipeClosed
+	}
+}
+
+func (c *PipeClient) WriteMessage(msg []byte) error {
+	if c.IsClosed() {
+		return ErrPipeClosed
+	}
+
+	c.mu.Lock()
+	c.writes = append(c.writes, msg)
+	c.mu.Unlock()
+
+	if c.OnWrite != nil {
+		c.OnWrite(c, msg)
+	}
+	return nil
+}
+
+func (c *PipeClient) Close() error {
+	c.once.Do(func() {
+		close(c.done)
+		c.life.Finish(ws.ErrClosed)
+	})
+	return nil
+}
+
+func (c *PipeClient) Done() <-chan struct{} {
+	return c.life.Done()
+}
+
+func (c *PipeClient) Err() error {
+	return c.life.Err()
+}
+
+// Push queues msg for ReadMessage.
+func (c *PipeClient) Push(msg []byte) {
+	c.in <- msg
+}
+
+// Writes returns the messages written so far.
+func (c *PipeClient) Writes() [][]byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([][]byte(nil), c.writes...)
+}
+
+func (c *PipeClient) IsClosed() bool {
+	select {
+	case <-c.done:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,47 @@
+package wsutil
+
+import "sync"
+
+// Lifecycle records the end of a connection. The zero value is ready to use.
+type Lifecycle struct {
+	mu   sync.Mutex
+	done chan struct{}
+	err  error
+	over bool
+}
+
+// Done returns a channel that is closed once Finish has been called.
+func (l *Lifecycle) Done() <-chan struct{} {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.doneLocked()
+}
+
+// Err returns nil until Finish has been called, and the error passed to the
+// first Finish afterwards.
+func (l *Lifecycle) Err() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.err
+}
+
+// Finish marks the connection as ended with err and closes Done.
+// Only the first call has an effect.
+func (l *Lifecycle) Finish(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.over {
+		return
+	}
+	l.over = true
+	l.err = err
+	close(l.doneLocked())
+}
+
+func (l *Lifecycle) doneLocked() chan struct{} {
+	if l.done == nil {
+		l.done = make(chan struct{})
+	}
+	return l.done
+}
@@ -0,0 +1,339 @@
+// Package orderbook maintains a local copy of a spot order book from the
+// diff-depth stream and REST depth snapshots.
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/book"
+	"github.com/IvanTurko/mexc-sdk-go/spot/rest"
+	"github.com/IvanTurko/mexc-sdk-go/spot/wsmarket"
+	"github.com/shopspring/decimal"
+)
+
+const snapshotLimit = 5000
+
+var (
+	// ErrVersionGap is reported through the resync callback when an update
+	// does not continue from the version the book holds.
+	ErrVersionGap = errors.New("order book version gap")
+
+	// ErrInvalidUpdate is reported through the resync callback when the
+	// stream delivers an update that cannot be decoded.
+	ErrInvalidUpdate = book.ErrInvalidUpdate
+
+	// ErrAlreadyStarted is returned when Start is called more than once.
+	ErrAlreadyStarted = book.ErrAlreadyStarted
+
+	// ErrClosed is returned by Start when Close is called before Start
+	// completes.
+	ErrClosed = book.ErrClosed
+)
+
+// Subscriber is the part of wsmarket.WSMarket used by OrderBook.
+type Subscriber interface {
+	Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error)
+}
+
+// Level is a single price level of the book.
+type Level struct {
+	Price    decimal.Decimal
+	Quantity decimal.Decimal
+}
+
+// Options configures OrderBook.
+type Options = func(*OrderBook)
+
+// OrderBook is a local spot order book kept in sync with the exchange.
+//
+// The book subscribes to wsmarket.NewDiffDepthSub, buffers updates while it
+// loads a snapshot from rest.OrderBookService and then applies every update
+// whose FromVersion continues the version it holds. A gap triggers a new
+// snapshot automatically. All methods are safe for concurrent use.
+type OrderBook struct {
+	symbol     string
+	market     Subscriber
+	snapshot   *rest.OrderBookService
+	interval   wsmarket.UpdateInterval
+	retryDelay time.Duration
+
+	onUpdate func(*OrderBook)
+	onResync func(reason error)
+	onError  func(error)
+
+	feed   *feed
+	syncer *book.Syncer[*wsmarket.DepthDelta, *rest.OrderBookDepths]
+}
+
+// NewOrderBook creates an OrderBook for symbol that streams updates through
+// market. Call Start to begin syncing.
+//
+// Panics:
+//   - symbol is empty
+//   - market is nil
+func NewOrderBook(symbol string, market Subscriber, opts ...Options) *OrderBook {
+	if symbol == "" {
+		panic("NewOrderBook: invalid symbol name")
+	}
+	if market == nil {
+		panic("NewOrderBook: market must not be nil")
+	}
+
+	b := &OrderBook{
+		symbol:     symbol,
+		market:     market,
+		snapshot:   rest.NewOrderBookService().Limit(snapshotLimit),
+		interval:   wsmarket.Update100ms,
+		retryDelay: 1 * time.Second,
+	}
+
+	for _, opt := range opts {
+		opt(b)
+	}
+
+	b.snapshot.Symbol(symbol)
+	b.feed = &feed{
+		symbol:   symbol,
+		market:   market,
+		snapshot: b.snapshot,
+		interval: b.interval,
+	}
+	b.syncer = book.NewSyncer(b.feed, book.Config{
+		RetryDelay: b.retryDelay,
+		OnUpdate:   b.notifyUpdate,
+		OnResync:   b.onResync,
+		OnError:    b.onError,
+	})
+	return b
+}
+
+// WithSnapshotService sets the service used to load depth snapshots.
+// Its symbol is overwritten with the symbol of the book.
+func WithSnapshotService(s *rest.OrderBookService) Options {
+	return func(b *OrderBook) {
+		b.snapshot = s
+	}
+}
+
+// WithUpdateInterval sets the aggregation interval of the diff-depth stream.
+func WithUpdateInterval(interval wsmarket.UpdateInterval) Options {
+	return func(b *OrderBook) {
+		b.interval = interval
+	}
+}
+
+// WithRetryDelay sets how long to wait before loading another snapshot when
+// the previous attempt failed or was older than the buffered updates.
+func WithRetryDelay(d time.Duration) Options {
+	return func(b *OrderBook) {
+		b.retryDelay = d
+	}
+}
+
+// WithOnUpdate registers a callback invoked after the book changes.
+// The callback runs on the goroutine that applied the change and may read
+// the book.
+func WithOnUpdate(f func(*OrderBook)) Options {
+	return func(b *OrderBook) {
+		b.onUpdate = f
+	}
+}
+
+// WithOnResync registers a callback invoked when the book drops out of sync
+// and starts loading a new snapshot. reason wraps ErrVersionGap or
+// ErrInvalidUpdate.
+func WithOnResync(f func(reason error)) Options {
+	return func(b *OrderBook) {
+		b.onResync = f
+	}
+}
+
+// WithOnError registers a callback for snapshot requests that fail.
+// The book keeps retrying after the retry delay.
+func WithOnError(f func(error)) Options {
+	return func(b *OrderBook) {
+		b.onError = f
+	}
+}
+
+// Start subscribes to the diff-depth stream and loads the first snapshot in
+// the background. The book becomes Ready once the snapshot is applied.
+func (b *OrderBook) Start(ctx context.Context) error {
+	return b.syncer.Start(ctx)
+}
+
+// Close unsubscribes from the stream and stops any pending snapshot load.
+// Safe to call multiple times.
+func (b *OrderBook) Close(ctx context.Context) error {
+	return b.syncer.Close(ctx)
+}
+
+// Symbol returns the symbol of the book.
+func (b *OrderBook) Symbol() string {
+	return b.symbol
+}
+
+// Ready reports whether the book is in sync with the exchange.
+func (b *OrderBook) Ready() bool {
+	return b.syncer.Ready()
+}
+
+// Version returns the version of the last applied update.
+func (b *OrderBook) Version() uint64 {
+	return b.feed.version.Load()
+}
+
+// BestBid returns the highest bid. ok is false when the side is empty or the
+// book is not Ready.
+func (b *OrderBook) BestBid() (level Level, ok bool) {
+	return b.best(b.syncer.Bids())
+}
+
+// BestAsk returns the lowest ask. ok is false when the side is empty or the
+// book is not Ready.
+func (b *OrderBook) BestAsk() (level Level, ok bool) {
+	return b.best(b.syncer.Asks())
+}
+
+// Bids returns the best n bids, highest first. n <= 0 returns every level.
+// Returns nil while the book is not Ready.
+func (b *OrderBook) Bids(n int) []Level {
+	return b.top(b.syncer.Bids(), n)
+}
+
+// Asks returns the best n asks, lowest first. n <= 0 returns every level.
+// Returns nil while the book is not Ready.
+func (b *OrderBook) Asks(n int) []Level {
+	return b.top(b.syncer.Asks(), n)
+}
+
+// BidQuantityAt returns the bid quantity resting at exactly price.
+func (b *OrderBook) BidQuantityAt(price decimal.Decimal) decimal.Decimal {
+	return b.quantityAt(b.syncer.Bids(), price)
+}
+
+// AskQuantityAt returns the ask quantity resting at exactly price.
+func (b *OrderBook) AskQuantityAt(price decimal.Decimal) decimal.Decimal {
+	return b.quantityAt(b.syncer.Asks(), price)
+}
+
+// BidDepthTo returns the total bid quantity priced at or above price.
+func (b *OrderBook) BidDepthTo(price decimal.Decimal) decimal.Decimal {
+	return b.depthTo(b.syncer.Bids(), price)
+}
+
+// AskDepthTo returns the total ask quantity priced at or below price.
+func (b *OrderBook) AskDepthTo(price decimal.Decimal) decimal.Decimal {
+	return b.depthTo(b.syncer.Asks(), price)
+}
+
+func (b *OrderBook) best(side *book.Side) (level Level, ok bool) {
+	b.syncer.View(func() {
+		var l book.Level
+		l, ok = side.Best()
+		level = Level(l)
+	})
+	return level, ok
+}
+
+func (b *OrderBook) top(side *book.Side, n int) []Level {
+	var out []Level
+	b.syncer.View(func() {
+		levels := side.Top(n)
+		out = make([]Level, len(levels))
+		for i, l := range levels {
+			out[i] = Level(l)
+		}
+	})
+	return out
+}
+
+func (b *OrderBook) quantityAt(side *book.Side, price decimal.Decimal) decimal.Decimal {
+	quantity := decimal.Zero
+	b.syncer.View(func() {
+		quantity = side.QuantityAt(price)
+	})
+	return quantity
+}
+
+func (b *OrderBook) depthTo(side *book.Side, price decimal.Decimal) decimal.Decimal {
+	depth := decimal.Zero
+	b.syncer.View(func() {
+		depth = side.DepthTo(price)
+	})
+	return depth
+}
+
+func (b *OrderBook) notifyUpdate() {
+	if b.onUpdate != nil {
+		b.onUpdate(b)
+	}
+}
+
+// feed streams diff-depth updates and sequences them by update id. Load and
+// Apply run with the syncer locked.
+type feed struct {
+	symbol   string
+	market   Subscriber
+	snapshot *rest.OrderBookService
+	interval wsmarket.UpdateInterval
+
+	version atomic.Uint64
+	// fresh is set after a snapshot is loaded; the first update applied on
+	// top of it only has to cover the next version, not start at it.
+	fresh bool
+}
+
+func (f *feed) Subscribe(ctx context.Context, onUpdate func(*wsmarket.DepthDelta), onInvalid func(error)) (book.Handle, error) {
+	sub := wsmarket.NewDiffDepthSub(f.symbol, f.interval, onUpdate).
+		SetOnInvalid(onInvalid)
+
+	handle, err := f.market.Subscribe(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return handle, nil
+}
+
+func (f *feed) Snapshot(ctx context.Context) (*rest.OrderBookDepths, error) {
+	return f.snapshot.Do(ctx)
+}
+
+func (f *feed) Load(snap *rest.OrderBookDepths, bids, asks *book.Side) {
+	for _, l := range snap.Bids {
+		bids.Set(l.Price, l.Quantity)
+	}
+	for _, l := range snap.Asks {
+		asks.Set(l.Price, l.Quantity)
+	}
+	f.version.Store(uint64(snap.LastUpdateId))
+	f.fresh = true
+}
+
+// Apply applies d if it continues the current version. Updates that are
+// already covered by the book are skipped.
+func (f *feed) Apply(d *wsmarket.DepthDelta, bids, asks *book.Side) (bool, error) {
+	version := f.version.Load()
+	if d.ToVersion <= version {
+		return false, nil
+	}
+
+	next := version + 1
+	if (f.fresh && d.FromVersion > next) || (!f.fresh && d.FromVersion != next) {
+		return false, fmt.Errorf("%w: have %d, got %d-%d", ErrVersionGap, version, d.FromVersion, d.ToVersion)
+	}
+
+	for _, l := range d.Bids {
+		bids.Set(l.Price, l.Quantity)
+	}
+	for _, l := range d.Asks {
+		asks.Set(l.Price, l.Quantity)
+	}
+	f.version.Store(d.ToVersion)
+	f.fresh = false
+	return true, nil
+}
@@ -0,0 +1,280 @@
+package orderbook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/spot/rest"
+	"github.com/IvanTurko/mexc-sdk-go/spot/wsmarket"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeHandle struct {
+	mu    sync.Mutex
+	calls int
+}
+
+func (h *fakeHandle) Unsubscribe(ctx context.Context) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.calls++
+	return nil
+}
+
+type fakeSubscriber struct {
+	handle *fakeHandle
+	subs   []wsmarket.Subscription
+	err    error
+}
+
+func (f *fakeSubscriber) Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.subs = append(f.subs, sub)
+	return f.handle, nil
+}
+
+// snapshotClient serves the given bodies in order, repeating the last one.
+func snapshotClient(bodies ...string) (*testutil.FakeHTTPClient, *int) {
+	var mu sync.Mutex
+	calls := 0
+	client := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			body := bodies[min(calls, len(bodies)-1)]
+			calls++
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+	return client, &calls
+}
+
+func d(s string) decimal.Decimal {
+	return decimal.RequireFromString(s)
+}
+
+func delta(from, to uint64, bids, asks [][2]string) *wsmarket.DepthDelta {
+	toLevels := func(in [][2]string) []wsmarket.DepthLevel {
+		var out []wsmarket.DepthLevel
+		for _, l := range in {
+			out = append(out, wsmarket.DepthLevel{Price: d(l[0]), Quantity: d(l[1])})
+		}
+		return out
+	}
+	return &wsmarket.DepthDelta{
+		Symbol:      "BTCUSDT",
+		Bids:        toLevels(bids),
+		Asks:        toLevels(asks),
+		FromVersion: from,
+		ToVersion:   to,
+	}
+}
+
+func newTestBook(t *testing.T, client transport.HTTPClient, opts ...Options) (*OrderBook, *fakeSubscriber) {
+	t.Helper()
+	sub := &fakeSubscriber{handle: &fakeHandle{}}
+	opts = append([]Options{
+		WithSnapshotService(rest.NewOrderBookService().WithClient(client)),
+		WithRetryDelay(time.Millisecond),
+	}, opts...)
+	return NewOrderBook("BTCUSDT", sub, opts...), sub
+}
+
+const snapshot100 = `{"lastUpdateId":100,"bids":[["99","1"],["98","2"]],"asks":[["101","1"],["102","3"]]}`
+
+func TestNewOrderBook_Panics(t *testing.T) {
+	assert.Panics(t, func() { NewOrderBook("", &fakeSubscriber{}) })
+	assert.Panics(t, func() { NewOrderBook("BTCUSDT", nil) })
+}
+
+func TestOrderBook_InitialSyncReplaysBuffer(t *testing.T) {
+	client, _ := snapshotClient(snapshot100)
+	b, sub := newTestBook(t, client)
+
+	b.syncer.HandleUpdate(delta(90, 95, [][2]string{{"50", "1"}}, nil))
+	b.syncer.HandleUpdate(delta(96, 102, [][2]string{{"99", "5"}}, nil))
+	b.syncer.HandleUpdate(delta(103, 103, nil, [][2]string{{"101", "0"}}))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Len(t, sub.subs, 1)
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	assert.Equal(t, uint64(103), b.Version())
+	assert.True(t, b.BidQuantityAt(d("99")).Equal(d("5")))
+	assert.True(t, b.BidQuantityAt(d("50")).IsZero())
+
+	ask, ok := b.BestAsk()
+	require.True(t, ok)
+	assert.True(t, ask.Price.Equal(d("102")))
+}
+
+func TestOrderBook_View(t *testing.T) {
+	client, _ := snapshotClient(snapshot100)
+	b, _ := newTestBook(t, client)
+
+	assert.Nil(t, b.Bids(0))
+	_, ok := b.BestBid()
+	assert.False(t, ok)
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	bid, ok := b.BestBid()
+	require.True(t, ok)
+	assert.True(t, bid.Price.Equal(d("99")))
+
+	assert.Len(t, b.Bids(1), 1)
+	assert.Len(t, b.Asks(0), 2)
+	assert.True(t, b.BidDepthTo(d("98")).Equal(d("3")))
+	assert.True(t, b.AskDepthTo(d("101")).Equal(d("1")))
+	assert.True(t, b.AskQuantityAt(d("102")).Equal(d("3")))
+}
+
+func TestOrderBook_GapTriggersResync(t *testing.T) {
+	client, calls := snapshotClient(
+		snapshot100,
+		`{"lastUpdateId":110,"bids":[["97","4"]],"asks":[["103","1"]]}`,
+	)
+
+	resyncs := make(chan error, 1)
+	updates := make(chan struct{}, 10)
+	b, _ := newTestBook(t, client,
+		WithOnResync(func(reason error) { resyncs <- reason }),
+		WithOnUpdate(func(*OrderBook) { updates <- struct{}{} }),
+	)
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	b.syncer.HandleUpdate(delta(101, 101, [][2]string{{"99", "2"}}, nil))
+	assert.Equal(t, uint64(101), b.Version())
+
+	b.syncer.HandleUpdate(delta(105, 111, [][2]string{{"96", "1"}}, nil))
+
+	select {
+	case reason := <-resyncs:
+		assert.True(t, errors.Is(reason, ErrVersionGap))
+	case <-time.After(time.Second):
+		t.Fatal("resync callback not called")
+	}
+
+	require.Eventually(t, func() bool { return b.Ready() && b.Version() == 111 }, time.Second, time.Millisecond)
+	assert.Equal(t, 2, *calls)
+
+	bid, ok := b.BestBid()
+	require.True(t, ok)
+	assert.True(t, bid.Price.Equal(d("97")))
+	assert.True(t, b.BidQuantityAt(d("96")).Equal(d("1")))
+	assert.True(t, b.BidQuantityAt(d("99")).IsZero())
+}
+
+func TestOrderBook_StaleSnapshotIsRetried(t *testing.T) {
+	client, calls := snapshotClient(
+		`{"lastUpdateId":50,"bids":[],"asks":[]}`,
+		snapshot100,
+	)
+	b, _ := newTestBook(t, client)
+
+	b.syncer.HandleUpdate(delta(95, 101, nil, [][2]string{{"104", "1"}}))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+
+	assert.Equal(t, 2, *calls)
+	assert.Equal(t, uint64(101), b.Version())
+	assert.True(t, b.AskQuantityAt(d("104")).Equal(d("1")))
+}
+
+func TestOrderBook_SnapshotErrorIsReportedAndRetried(t *testing.T) {
+	var mu sync.Mutex
+	calls := 0
+	client := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			mu.Lock()
+			defer mu.Unlock()
+			calls++
+			if calls == 1 {
+				return nil, fmt.Errorf("connection reset")
+			}
+			return &transport.Response{StatusCode: 200, Body: []byte(snapshot100)}, nil
+		},
+	}
+
+	errs := make(chan error, 1)
+	b, _ := newTestBook(t, client, WithOnError(func(err error) { errs <- err }))
+
+	require.NoError(t, b.Start(context.Background()))
+	require.Eventually(t, b.Ready, time.Second, time.Millisecond)
+	assert.Error(t, <-errs)
+}
+
+func TestOrderBook_StartAndClose(t *testing.T) {
+	client, _ := snapshotClient(snapshot100)
+	b, sub := newTestBook(t, client)
+
+	require.NoError(t, b.Start(context.Background()))
+	assert.ErrorIs(t, b.Start(context.Background()), ErrAlreadyStarted)
+
+	require.NoError(t, b.Close(context.Background()))
+	require.NoError(t, b.Close(context.Background()))
+	assert.Equal(t, 1, sub.handle.calls)
+	assert.False(t, b.Ready())
+}
+
+func TestOrderBook_StartSubscribeError(t *testing.T) {
+	client, _ := snapshotClient(snapshot100)
+	b, sub := newTestBook(t, client)
+	sub.err = errors.New("subscribe failed")
+
+	assert.Error(t, b.Start(context.Background()))
+
+	sub.err = nil
+	assert.NoError(t, b.Start(context.Background()))
+	require.NoError(t, b.Close(context.Background()))
+}
+
+// blockingSubscriber holds Subscribe until release is closed.
+type blockingSubscriber struct {
+	fakeSubscriber
+	entered chan struct{}
+	release chan struct{}
+}
+
+func (f *blockingSubscriber) Subscribe(ctx context.Context, sub wsmarket.Subscription) (wsmarket.SubscriptionHandle, error) {
+	close(f.entered)
+	<-f.release
+	return f.fakeSubscriber.Subscribe(ctx, sub)
+}
+
+func TestOrderBook_CloseDuringSubscribe(t *testing.T) {
+	client, calls := snapshotClient(snapshot100)
+	sub := &blockingSubscriber{
+		fakeSubscriber: fakeSubscriber{handle: &fakeHandle{}},
+		entered:        make(chan struct{}),
+		release:        make(chan struct{}),
+	}
+	b := NewOrderBook("BTCUSDT", sub, WithSnapshotService(rest.NewOrderBookService().WithClient(client)))
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- b.Start(context.Background()) }()
+
+	<-sub.entered
+	require.NoError(t, b.Close(context.Background()))
+	close(sub.release)
+
+	assert.ErrorIs(t, <-errCh, ErrClosed)
+	assert.Equal(t, 1, sub.handle.calls)
+	assert.Equal(t, 0, *calls)
+	assert.False(t, b.Ready())
+	assert.ErrorIs(t, b.Start(context.Background()), ErrAlreadyStarted)
+}
@@ -0,0 +1,132 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Account represents the spot account information.
+type Account struct {
+	CanTrade    bool      `json:"canTrade"`
+	CanWithdraw bool      `json:"canWithdraw"`
+	CanDeposit  bool      `json:"canDeposit"`
+	UpdateTime  int64     `json:"updateTime"`
+	AccountType string    `json:"accountType"`
+	Balances    []Balance `json:"balances"`
+	Permissions []string  `json:"permissions"`
+}
+
+// Balance represents the balance of a single asset.
+type Balance struct {
+	Asset  string          `json:"asset"`
+	Free   decimal.Decimal `json:"free"`
+	Locked decimal.Decimal `json:"locked"`
+}
+
+// AccountService gets the current account information.
+type AccountService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewAccountService creates a new AccountService.
+func NewAccountService(apiKey, secretKey string) *AccountService {
+	return &AccountService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AccountService) WithClient(client transport.HTTPClient) *AccountService {
+	s.client = client
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *AccountService) RecvWindow(ms int64) *AccountService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *AccountService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("AccountService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *AccountService) Do(ctx context.Context) (*Account, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/account").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "AccountService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[Account](resp.Body, op)
+}
+
+func (s *AccountService) validate() error {
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			return errors.New("recvWindow must be between 1 and 60000")
+		}
+	}
+	return nil
+}
+
+func (s *AccountService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,94 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccountService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewAccountService("", "").RecvWindow(5000).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("recvWindow too large", func(t *testing.T) {
+		err := NewAccountService("", "").RecvWindow(60001).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "recvWindow must be between 1 and 60000")
+	})
+}
+
+func TestAccountService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewAccountService("api-key", "secret").RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestAccountService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewAccountService("", "").RecvWindow(0).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "AccountService.Validate", sdkErr.Op())
+}
+
+func TestAccountService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/account")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"makerCommission": null,
+					"takerCommission": null,
+					"buyerCommission": null,
+					"sellerCommission": null,
+					"canTrade": true,
+					"canWithdraw": true,
+					"canDeposit": true,
+					"updateTime": null,
+					"accountType": "SPOT",
+					"balances": [
+						{"asset": "BTC", "free": "0.5", "locked": "0.1"},
+						{"asset": "USDT", "free": "1000.25", "locked": "0"}
+					],
+					"permissions": ["SPOT"]
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewAccountService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.True(t, result.CanTrade)
+	assert.True(t, result.CanWithdraw)
+	assert.True(t, result.CanDeposit)
+	assert.Equal(t, "SPOT", result.AccountType)
+	assert.Equal(t, []string{"SPOT"}, result.Permissions)
+	require.Len(t, result.Balances, 2)
+	assert.Equal(t, "BTC", result.Balances[0].Asset)
+	testutil.AssertDecimalEqual(t, result.Balances[0].Free, "0.5", "free mismatch")
+	testutil.AssertDecimalEqual(t, result.Balances[0].Locked, "0.1", "locked mismatch")
+}
@@ -0,0 +1,149 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// AggTradesService gets the compressed, aggregate trades for a symbol.
+type AggTradesService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// NewAggTradesService creates a new AggTradesService.
+func NewAggTradesService() *AggTradesService {
+	return &AggTradesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AggTradesService) WithClient(client transport.HTTPClient) *AggTradesService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the trades.
+func (s *AggTradesService) Symbol(symbol string) *AggTradesService {
+	s.symbol = symbol
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *AggTradesService) StartTime(ms int64) *AggTradesService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *AggTradesService) EndTime(ms int64) *AggTradesService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of trades to return.
+func (s *AggTradesService) Limit(n int) *AggTradesService {
+	s.limit = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *AggTradesService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("AggTradesService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *AggTradesService) Do(ctx context.Context) ([]AggTrade, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/aggTrades").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "AggTradesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	trades, err := decodeResponse[[]AggTrade](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *trades, nil
+}
+
+func (s *AggTradesService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *AggTradesService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	return q
+}
+
+// AggTrade represents an aggregate trade.
+type AggTrade struct {
+	Price        decimal.Decimal `json:"p"`
+	Quantity     decimal.Decimal `json:"q"`
+	Time         int64           `json:"T"`
+	IsBuyerMaker bool            `json:"m"`
+	IsBestMatch  bool            `json:"M"`
+}
@@ -0,0 +1,109 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggTradesService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewAggTradesService().
+			Symbol("BTCUSDT").
+			StartTime(1000).
+			EndTime(2000).
+			Limit(10).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("start after end", func(t *testing.T) {
+		err := NewAggTradesService().Symbol("BTCUSDT").StartTime(2000).EndTime(1000).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "startTime must not be after endTime")
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewAggTradesService().Limit(0).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "limit must be between 1 and 1000")
+	})
+}
+
+func TestAggTradesService_buildQuery(t *testing.T) {
+	t.Run("all fields set", func(t *testing.T) {
+		q := NewAggTradesService().
+			Symbol("BTCUSDT").
+			StartTime(1000).
+			EndTime(2000).
+			Limit(10).
+			buildQuery()
+
+		assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+		assert.Equal(t, "1000", q.Get("startTime"))
+		assert.Equal(t, "2000", q.Get("endTime"))
+		assert.Equal(t, "10", q.Get("limit"))
+	})
+
+	t.Run("symbol only", func(t *testing.T) {
+		q := NewAggTradesService().Symbol("BTCUSDT").buildQuery()
+		assert.Empty(t, q.Get("startTime"))
+		assert.Empty(t, q.Get("endTime"))
+		assert.Empty(t, q.Get("limit"))
+	})
+}
+
+func TestAggTradesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/aggTrades")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[{
+					"a": null,
+					"f": null,
+					"l": null,
+					"p": "46782.67",
+					"q": "0.0038",
+					"T": 1641380483000,
+					"m": false,
+					"M": true
+				}]`),
+			}, nil
+		},
+	}
+
+	result, err := NewAggTradesService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	assert.Equal(t, int64(1641380483000), result[0].Time)
+	assert.False(t, result[0].IsBuyerMaker)
+	assert.True(t, result[0].IsBestMatch)
+	testutil.AssertDecimalEqual(t, result[0].Price, "46782.67", "price mismatch")
+	testutil.AssertDecimalEqual(t, result[0].Quantity, "0.0038", "quantity mismatch")
+}
+
+func TestAggTradesService_Do_DecodeError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 200, Body: []byte(`{}`)}, nil
+		},
+	}
+
+	result, err := NewAggTradesService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
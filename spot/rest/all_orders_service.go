@@ -0,0 +1,176 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// AllOrdersService gets all orders on a symbol within a time range.
+// Page through older orders by moving the time range window.
+type AllOrdersService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbol     string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewAllOrdersService creates a new AllOrdersService.
+func NewAllOrdersService(apiKey, secretKey string) *AllOrdersService {
+	return &AllOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AllOrdersService) WithClient(client transport.HTTPClient) *AllOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the orders.
+func (s *AllOrdersService) Symbol(symbol string) *AllOrdersService {
+	s.symbol = symbol
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *AllOrdersService) StartTime(ms int64) *AllOrdersService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *AllOrdersService) EndTime(ms int64) *AllOrdersService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of orders to return.
+func (s *AllOrdersService) Limit(n int) *AllOrdersService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *AllOrdersService) RecvWindow(ms int64) *AllOrdersService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *AllOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("AllOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *AllOrdersService) Do(ctx context.Context) ([]Order, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/allOrders").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "AllOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]Order](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *AllOrdersService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *AllOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,110 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllOrdersService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewAllOrdersService("", "").
+			Symbol("BTCUSDT").
+			StartTime(1000).
+			EndTime(2000).
+			Limit(1000).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewAllOrdersService("", "").
+			StartTime(2000).
+			EndTime(1000).
+			Limit(0).
+			RecvWindow(60001).
+			validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "startTime must not be after endTime")
+		assert.Contains(t, err.Error(), "limit must be between 1 and 1000")
+		assert.Contains(t, err.Error(), "recvWindow must be between 1 and 60000")
+	})
+}
+
+func TestAllOrdersService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	t.Run("all fields set", func(t *testing.T) {
+		svc := NewAllOrdersService("api-key", "secret").
+			Symbol("BTCUSDT").
+			StartTime(1000).
+			EndTime(2000).
+			Limit(100).
+			RecvWindow(5000)
+		svc.timestamp = mockTime
+
+		q := svc.buildQuery()
+
+		assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+		assert.Equal(t, "1000", q.Get("startTime"))
+		assert.Equal(t, "2000", q.Get("endTime"))
+		assert.Equal(t, "100", q.Get("limit"))
+		assert.Equal(t, "5000", q.Get("recvWindow"))
+		assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+		assert.NotEmpty(t, q.Get("signature"))
+	})
+
+	t.Run("minimal fields", func(t *testing.T) {
+		svc := NewAllOrdersService("api-key", "secret").Symbol("BTCUSDT")
+		svc.timestamp = mockTime
+
+		q := svc.buildQuery()
+
+		assert.Empty(t, q.Get("startTime"))
+		assert.Empty(t, q.Get("endTime"))
+		assert.Empty(t, q.Get("limit"))
+		assert.NotEmpty(t, q.Get("signature"))
+	})
+}
+
+func TestAllOrdersService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewAllOrdersService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "AllOrdersService.Validate", sdkErr.Op())
+}
+
+func TestAllOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/allOrders")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "1000", query.Get("startTime"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`[` + orderJSON + `,` + orderJSON + `]`)}, nil
+		},
+	}
+
+	result, err := NewAllOrdersService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("LTCBTC").
+		StartTime(1000).
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, int64(1499827319559), result[0].Time)
+}
@@ -0,0 +1,100 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// AvgPriceService gets the current average price for a symbol.
+type AvgPriceService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewAvgPriceService creates a new AvgPriceService.
+func NewAvgPriceService() *AvgPriceService {
+	return &AvgPriceService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *AvgPriceService) WithClient(client transport.HTTPClient) *AvgPriceService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the average price.
+func (s *AvgPriceService) Symbol(symbol string) *AvgPriceService {
+	s.symbol = symbol
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *AvgPriceService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("AvgPriceService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *AvgPriceService) Do(ctx context.Context) (*AvgPrice, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/avgPrice").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "AvgPriceService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[AvgPrice](resp.Body, op)
+}
+
+func (s *AvgPriceService) validate() error {
+	if s.symbol == "" {
+		return errors.New("symbol is required")
+	}
+	return nil
+}
+
+func (s *AvgPriceService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	return q
+}
+
+// AvgPrice represents the average price over a window of minutes.
+type AvgPrice struct {
+	Mins  int             `json:"mins"`
+	Price decimal.Decimal `json:"price"`
+}
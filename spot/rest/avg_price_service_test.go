@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvgPriceService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewAvgPriceService().Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "AvgPriceService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "symbol is required")
+}
+
+func TestAvgPriceService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/avgPrice")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"mins": 5, "price": "9.35751834"}`),
+			}, nil
+		},
+	}
+
+	result, err := NewAvgPriceService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, 5, result.Mins)
+	testutil.AssertDecimalEqual(t, result.Price, "9.35751834", "price mismatch")
+}
@@ -0,0 +1,374 @@
+package rest
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+const maxBatchOrders = 20
+
+// BatchOrder describes a single order within a batch.
+type BatchOrder struct {
+	symbol           string
+	side             OrderSide
+	_type            OrderType
+	quantity         *decimal.Decimal
+	quoteOrderQty    *decimal.Decimal
+	price            *decimal.Decimal
+	newClientOrderId *string
+}
+
+// NewBatchOrder creates a new BatchOrder.
+func NewBatchOrder() *BatchOrder {
+	return &BatchOrder{}
+}
+
+// Symbol sets the symbol for the order.
+func (b *BatchOrder) Symbol(symbol string) *BatchOrder {
+	b.symbol = symbol
+	return b
+}
+
+// Side sets the side of the order.
+func (b *BatchOrder) Side(side OrderSide) *BatchOrder {
+	b.side = side
+	return b
+}
+
+// Type sets the type of the order.
+func (b *BatchOrder) Type(orderType OrderType) *BatchOrder {
+	b._type = orderType
+	return b
+}
+
+// Quantity sets the quantity of the order.
+func (b *BatchOrder) Quantity(quantity decimal.Decimal) *BatchOrder {
+	b.quantity = &quantity
+	return b
+}
+
+// QuoteOrderQty sets the quote order quantity of the order.
+func (b *BatchOrder) QuoteOrderQty(qty decimal.Decimal) *BatchOrder {
+	b.quoteOrderQty = &qty
+	return b
+}
+
+// Price sets the price of the order.
+func (b *BatchOrder) Price(price decimal.Decimal) *BatchOrder {
+	b.price = &price
+	return b
+}
+
+// NewClientOrderId sets the new client order ID of the order.
+func (b *BatchOrder) NewClientOrderId(id string) *BatchOrder {
+	b.newClientOrderId = &id
+	return b
+}
+
+func (b *BatchOrder) validate() []string {
+	var errs []string
+
+	if b.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if !b.side.isValid() {
+		errs = append(errs, "side is invalid")
+	}
+
+	if !b._type.isValid() {
+		errs = append(errs, "type is invalid")
+	}
+
+	if b.quantity != nil && b.quantity.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "quantity must be greater than zero")
+	}
+
+	if b.quoteOrderQty != nil && b.quoteOrderQty.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "quoteOrderQty must be greater than zero")
+	}
+
+	if b.price != nil && b.price.Cmp(decimal.Zero) <= 0 {
+		errs = append(errs, "price must be greater than zero")
+	}
+
+	return errs
+}
+
+type batchOrderParams struct {
+	Symbol           string `json:"symbol"`
+	Side             string `json:"side"`
+	Type             string `json:"type"`
+	Quantity         string `json:"quantity,omitempty"`
+	QuoteOrderQty    string `json:"quoteOrderQty,omitempty"`
+	Price            string `json:"price,omitempty"`
+	NewClientOrderId string `json:"newClientOrderId,omitempty"`
+}
+
+func (b *BatchOrder) params(clientOrderId string) batchOrderParams {
+	p := batchOrderParams{
+		Symbol:           b.symbol,
+		Side:             string(b.side),
+		Type:             string(b._type),
+		NewClientOrderId: clientOrderId,
+	}
+	if b.quantity != nil {
+		p.Quantity = b.quantity.String()
+	}
+	if b.quoteOrderQty != nil {
+		p.QuoteOrderQty = b.quoteOrderQty.String()
+	}
+	if b.price != nil {
+		p.Price = b.price.String()
+	}
+	return p
+}
+
+// BatchOrderResult represents the outcome of a single order within a batch.
+// Exactly one of Order and Err is set.
+type BatchOrderResult struct {
+	// Index is the position of the order in the submitted batch. Results are
+	// matched to orders by NewClientOrderId, which is generated for orders
+	// that do not set one.
+	Index            int
+	Order            *PlacedOrder
+	Err              error
+	NewClientOrderId string
+}
+
+type batchOrderItem struct {
+	PlacedOrder
+	NewClientOrderId string `json:"newClientOrderId"`
+	ClientOrderId    string `json:"clientOrderId"`
+	Code             int    `json:"code"`
+	Message          string `json:"msg"`
+}
+
+// BatchOrdersService places up to 20 orders on the same symbol in a single request.
+type BatchOrdersService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	orders     []*BatchOrder
+	recvWindow *int64
+	timestamp  func() int64
+	newOrderId func() string
+}
+
+// NewBatchOrdersService creates a new BatchOrdersService.
+func NewBatchOrdersService(apiKey, secretKey string) *BatchOrdersService {
+	return &BatchOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		newOrderId: randomClientOrderId,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *BatchOrdersService) WithClient(client transport.HTTPClient) *BatchOrdersService {
+	s.client = client
+	return s
+}
+
+// Orders sets the orders of the batch.
+func (s *BatchOrdersService) Orders(orders ...*BatchOrder) *BatchOrdersService {
+	s.orders = orders
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *BatchOrdersService) RecvWindow(ms int64) *BatchOrdersService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *BatchOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("BatchOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service. A failure of an individual order is reported in
+// its BatchOrderResult and does not produce an error.
+func (s *BatchOrdersService) Do(ctx context.Context) ([]BatchOrderResult, error) {
+	ids := s.clientOrderIds()
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/batchOrders").
+		WithQuery(s.buildQuery(ids)).
+		Build()
+
+	op := "BatchOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	items, err := decodeResponse[[]batchOrderItem](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+
+	indexes := make(map[string]int, len(ids))
+	for i, id := range ids {
+		indexes[id] = i
+	}
+
+	results := make([]BatchOrderResult, len(*items))
+	for i, item := range *items {
+		id := item.NewClientOrderId
+		if id == "" {
+			id = item.ClientOrderId
+		}
+		index, ok := indexes[id]
+		if !ok {
+			return nil, sdkerr.NewSDKError().
+				WithSubsys(subsys).
+				WithOp(op).
+				WithKind(sdkerr.ErrDecodeError).
+				WithMessage(fmt.Sprintf("result %d: unknown client order id %q", i, id))
+		}
+		delete(indexes, id)
+
+		results[i] = BatchOrderResult{
+			Index:            index,
+			NewClientOrderId: id,
+		}
+		if item.Code != 0 {
+			results[i].Err = errs.ErrorCode(item.Code)
+			continue
+		}
+		order := item.PlacedOrder
+		results[i].Order = &order
+	}
+	return results, nil
+}
+
+func (s *BatchOrdersService) validate() error {
+	var errs []string
+
+	if len(s.orders) == 0 {
+		errs = append(errs, "at least one order is required")
+	}
+
+	if len(s.orders) > maxBatchOrders {
+		errs = append(errs, fmt.Sprintf("no more than %d orders are allowed", maxBatchOrders))
+	}
+
+	seen := make(map[string]bool, len(s.orders))
+	for i, o := range s.orders {
+		if o == nil {
+			errs = append(errs, fmt.Sprintf("order %d: order is nil", i))
+			continue
+		}
+		if o.newClientOrderId != nil {
+			if seen[*o.newClientOrderId] {
+				errs = append(errs, fmt.Sprintf("order %d: duplicate newClientOrderId", i))
+			}
+			seen[*o.newClientOrderId] = true
+		}
+		for _, e := range o.validate() {
+			errs = append(errs, fmt.Sprintf("order %d: %s", i, e))
+		}
+		if i > 0 && s.orders[0] != nil && o.symbol != s.orders[0].symbol {
+			errs = append(errs, fmt.Sprintf("order %d: all orders must have the same symbol", i))
+		}
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+// clientOrderIds returns the client order id of every order, generating
+// one where it is not set.
+func (s *BatchOrdersService) clientOrderIds() []string {
+	ids := make([]string, len(s.orders))
+	for i, o := range s.orders {
+		if o != nil && o.newClientOrderId != nil {
+			ids[i] = *o.newClientOrderId
+		} else {
+			ids[i] = s.newOrderId()
+		}
+	}
+	return ids
+}
+
+func (s *BatchOrdersService) buildQuery(ids []string) url.Values {
+	params := make([]batchOrderParams, 0, len(s.orders))
+	for i, o := range s.orders {
+		if o == nil {
+			continue
+		}
+		params = append(params, o.params(ids[i]))
+	}
+
+	// Marshalling cannot fail: batchOrderParams holds only strings.
+	batch, _ := json.Marshal(params)
+
+	q := make(url.Values)
+
+	q.Add("batchOrders", string(batch))
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+func randomClientOrderId() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
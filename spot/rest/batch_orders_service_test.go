@@ -0,0 +1,226 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBatchOrder(price string) *BatchOrder {
+	return NewBatchOrder().
+		Symbol("BTCUSDT").
+		Side(OrderSideBuy).
+		Type(OrderTypeLimit).
+		Quantity(decimal.RequireFromString("0.01")).
+		Price(decimal.RequireFromString(price))
+}
+
+func TestBatchOrdersService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewBatchOrdersService("", "").
+			Orders(newTestBatchOrder("100"), newTestBatchOrder("101")).
+			RecvWindow(5000).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("no orders", func(t *testing.T) {
+		err := NewBatchOrdersService("", "").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one order is required")
+	})
+
+	t.Run("too many orders", func(t *testing.T) {
+		orders := make([]*BatchOrder, maxBatchOrders+1)
+		for i := range orders {
+			orders[i] = newTestBatchOrder("100")
+		}
+
+		err := NewBatchOrdersService("", "").Orders(orders...).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no more than 20 orders are allowed")
+	})
+
+	t.Run("invalid order is reported with its index", func(t *testing.T) {
+		bad := NewBatchOrder().Symbol("BTCUSDT").Side(OrderSide("INVALID")).Type(OrderTypeLimit)
+
+		err := NewBatchOrdersService("", "").Orders(newTestBatchOrder("100"), bad).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "order 1: side is invalid")
+	})
+
+	t.Run("mixed symbols", func(t *testing.T) {
+		other := newTestBatchOrder("100").Symbol("ETHUSDT")
+
+		err := NewBatchOrdersService("", "").Orders(newTestBatchOrder("100"), other).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "order 1: all orders must have the same symbol")
+	})
+
+	t.Run("duplicate client order id", func(t *testing.T) {
+		err := NewBatchOrdersService("", "").
+			Orders(newTestBatchOrder("100").NewClientOrderId("a"), newTestBatchOrder("101").NewClientOrderId("a")).
+			validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "order 1: duplicate newClientOrderId")
+	})
+
+	t.Run("nil order", func(t *testing.T) {
+		err := NewBatchOrdersService("", "").Orders(nil).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "order 0: order is nil")
+	})
+}
+
+func TestBatchOrdersService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewBatchOrdersService("api-key", "secret").
+		Orders(
+			newTestBatchOrder("100").NewClientOrderId("a"),
+			NewBatchOrder().Symbol("BTCUSDT").Side(OrderSideSell).Type(OrderTypeMarket).QuoteOrderQty(decimal.NewFromInt(10)),
+		).
+		RecvWindow(5000)
+	svc.timestamp = mockTime
+	svc.newOrderId = func() string { return "gen" }
+
+	q := svc.buildQuery(svc.clientOrderIds())
+
+	var batch []map[string]string
+	require.NoError(t, json.Unmarshal([]byte(q.Get("batchOrders")), &batch))
+	require.Len(t, batch, 2)
+
+	assert.Equal(t, map[string]string{
+		"symbol":           "BTCUSDT",
+		"side":             "BUY",
+		"type":             "LIMIT",
+		"quantity":         "0.01",
+		"price":            "100",
+		"newClientOrderId": "a",
+	}, batch[0])
+	assert.Equal(t, map[string]string{
+		"symbol":           "BTCUSDT",
+		"side":             "SELL",
+		"type":             "MARKET",
+		"quoteOrderQty":    "10",
+		"newClientOrderId": "gen",
+	}, batch[1])
+
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestBatchOrdersService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewBatchOrdersService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "BatchOrdersService.Validate", sdkErr.Op())
+}
+
+func TestBatchOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/batchOrders")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[
+					{
+						"newClientOrderId": "b",
+						"msg": "Insufficient position",
+						"code": 30004
+					},
+					{
+						"symbol": "BTCUSDT",
+						"clientOrderId": "gen",
+						"orderId": "1196315350023612316",
+						"orderListId": -1,
+						"price": "100",
+						"origQty": "0.01",
+						"type": "LIMIT",
+						"side": "BUY",
+						"transactTime": 1666676533741
+					}
+				]`),
+			}, nil
+		},
+	}
+
+	svc := NewBatchOrdersService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Orders(newTestBatchOrder("100"), newTestBatchOrder("101").NewClientOrderId("b"))
+	svc.newOrderId = func() string { return "gen" }
+
+	result, err := svc.Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+
+	assert.Equal(t, 1, result[0].Index)
+	assert.Nil(t, result[0].Order)
+	assert.Equal(t, "b", result[0].NewClientOrderId)
+	assert.True(t, errors.Is(result[0].Err, errs.ErrInsufficientPosition))
+
+	assert.Equal(t, 0, result[1].Index)
+	assert.Equal(t, "gen", result[1].NewClientOrderId)
+	assert.NoError(t, result[1].Err)
+	require.NotNil(t, result[1].Order)
+	assert.Equal(t, "1196315350023612316", result[1].Order.OrderID)
+	testutil.AssertDecimalEqual(t, result[1].Order.Price, "100", "price mismatch")
+}
+
+func TestBatchOrdersService_Do_UnmatchedResult(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`[{"newClientOrderId": "other", "msg": "Insufficient position", "code": 30004}]`),
+			}, nil
+		},
+	}
+
+	result, err := NewBatchOrdersService("", "").
+		WithClient(fakeClient).
+		Orders(newTestBatchOrder("100").NewClientOrderId("a")).
+		Do(context.Background())
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, sdkerr.ErrDecodeError)
+}
+
+func TestBatchOrdersService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 400,
+				Body:       []byte(`{"code": 700002, "msg": "Signature for this request is not valid."}`),
+			}, nil
+		},
+	}
+
+	result, err := NewBatchOrdersService("", "").
+		WithClient(fakeClient).
+		Orders(newTestBatchOrder("100")).
+		Do(context.Background())
+
+	assert.Nil(t, result)
+	assert.ErrorIs(t, err, sdkerr.ErrAPIError)
+	assert.ErrorIs(t, err, errs.ErrSignatureInvalid)
+}
@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// BookTickerService gets the best price and quantity on the order book
+// for a symbol or all symbols.
+type BookTickerService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewBookTickerService creates a new BookTickerService.
+func NewBookTickerService() *BookTickerService {
+	return &BookTickerService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *BookTickerService) WithClient(client transport.HTTPClient) *BookTickerService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single symbol.
+func (s *BookTickerService) Symbol(symbol string) *BookTickerService {
+	s.symbol = symbol
+	return s
+}
+
+// Do executes the service.
+func (s *BookTickerService) Do(ctx context.Context) ([]BookTicker, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/ticker/bookTicker").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "BookTickerService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeOneOrMany[BookTicker](resp.Body, s.symbol != "", op)
+}
+
+func (s *BookTickerService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != "" {
+		q.Add("symbol", s.symbol)
+	}
+	return q
+}
+
+// BookTicker represents the best bid and ask of a symbol.
+type BookTicker struct {
+	Symbol   string          `json:"symbol"`
+	BidPrice decimal.Decimal `json:"bidPrice"`
+	BidQty   decimal.Decimal `json:"bidQty"`
+	AskPrice decimal.Decimal `json:"askPrice"`
+	AskQty   decimal.Decimal `json:"askQty"`
+}
@@ -0,0 +1,55 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBookTickerService_buildQuery(t *testing.T) {
+	t.Run("symbol", func(t *testing.T) {
+		q := NewBookTickerService().Symbol("BTCUSDT").buildQuery()
+		assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+	})
+
+	t.Run("no symbol", func(t *testing.T) {
+		q := NewBookTickerService().buildQuery()
+		assert.Empty(t, q)
+	})
+}
+
+func TestBookTickerService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/ticker/bookTicker")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"symbol": "AEUSDT",
+					"bidPrice": "0.11001",
+					"bidQty": "115.59",
+					"askPrice": "0.11127",
+					"askQty": "215.48"
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewBookTickerService().WithClient(fakeClient).Symbol("AEUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	bt := result[0]
+	assert.Equal(t, "AEUSDT", bt.Symbol)
+	testutil.AssertDecimalEqual(t, bt.BidPrice, "0.11001", "bid price mismatch")
+	testutil.AssertDecimalEqual(t, bt.BidQty, "115.59", "bid qty mismatch")
+	testutil.AssertDecimalEqual(t, bt.AskPrice, "0.11127", "ask price mismatch")
+	testutil.AssertDecimalEqual(t, bt.AskQty, "215.48", "ask qty mismatch")
+}
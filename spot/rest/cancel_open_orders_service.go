@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// CancelOpenOrdersService cancels all open orders on up to 5 symbols.
+type CancelOpenOrdersService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbols    []string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewCancelOpenOrdersService creates a new CancelOpenOrdersService.
+func NewCancelOpenOrdersService(apiKey, secretKey string) *CancelOpenOrdersService {
+	return &CancelOpenOrdersService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelOpenOrdersService) WithClient(client transport.HTTPClient) *CancelOpenOrdersService {
+	s.client = client
+	return s
+}
+
+// Symbols sets the symbols whose open orders are canceled.
+func (s *CancelOpenOrdersService) Symbols(symbols ...string) *CancelOpenOrdersService {
+	s.symbols = symbols
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CancelOpenOrdersService) RecvWindow(ms int64) *CancelOpenOrdersService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelOpenOrdersService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelOpenOrdersService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelOpenOrdersService) Do(ctx context.Context) ([]CanceledOrder, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodDelete).
+		WithPath("/api/v3/openOrders").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CancelOpenOrdersService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	orders, err := decodeResponse[[]CanceledOrder](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *orders, nil
+}
+
+func (s *CancelOpenOrdersService) validate() error {
+	var errs []string
+
+	if len(s.symbols) == 0 {
+		errs = append(errs, "at least one symbol is required")
+	}
+
+	if len(s.symbols) > 5 {
+		errs = append(errs, "no more than 5 symbols are allowed")
+	}
+
+	for _, sym := range s.symbols {
+		if sym == "" {
+			errs = append(errs, "symbols cannot contain empty values")
+			break
+		}
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelOpenOrdersService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", strings.Join(s.symbols, ","))
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
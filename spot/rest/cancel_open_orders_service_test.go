@@ -0,0 +1,68 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOpenOrdersService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewCancelOpenOrdersService("", "").Symbols("BTCUSDT", "ETHUSDT").validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("no symbols", func(t *testing.T) {
+		err := NewCancelOpenOrdersService("", "").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "at least one symbol is required")
+	})
+
+	t.Run("too many symbols", func(t *testing.T) {
+		err := NewCancelOpenOrdersService("", "").Symbols("A", "B", "C", "D", "E", "F").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "no more than 5 symbols are allowed")
+	})
+}
+
+func TestCancelOpenOrdersService_buildQuery(t *testing.T) {
+	svc := NewCancelOpenOrdersService("api-key", "secret").Symbols("BTCUSDT", "ETHUSDT")
+	svc.timestamp = func() int64 { return 1620000000000 }
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "BTCUSDT,ETHUSDT", q.Get("symbol"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCancelOpenOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/openOrders")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[
+					{"symbol": "BTCUSDT", "orderId": "1", "price": "1", "origQty": "1", "executedQty": "0", "cummulativeQuoteQty": "0", "status": "CANCELED", "type": "LIMIT", "side": "BUY"},
+					{"symbol": "ETHUSDT", "orderId": "2", "price": "1", "origQty": "1", "executedQty": "0.5", "cummulativeQuoteQty": "0.5", "status": "PARTIALLY_CANCELED", "type": "LIMIT", "side": "SELL"}
+				]`),
+			}, nil
+		},
+	}
+
+	result, err := NewCancelOpenOrdersService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbols("BTCUSDT", "ETHUSDT").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, OrderStatusPartiallyCanceled, result[1].Status)
+	assert.Equal(t, OrderSideSell, result[1].Side)
+}
@@ -0,0 +1,184 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// CanceledOrder represents an order that has been canceled.
+type CanceledOrder struct {
+	Symbol              string          `json:"symbol"`
+	OrigClientOrderID   string          `json:"origClientOrderId"`
+	OrderID             string          `json:"orderId"`
+	ClientOrderID       string          `json:"clientOrderId"`
+	Price               decimal.Decimal `json:"price"`
+	OrigQty             decimal.Decimal `json:"origQty"`
+	ExecutedQty         decimal.Decimal `json:"executedQty"`
+	CummulativeQuoteQty decimal.Decimal `json:"cummulativeQuoteQty"`
+	Status              OrderStatus     `json:"status"`
+	TimeInForce         string          `json:"timeInForce"`
+	Type                OrderType       `json:"type"`
+	Side                OrderSide       `json:"side"`
+}
+
+// CancelOrderService cancels an active order.
+type CancelOrderService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbol            string
+	orderId           *string
+	origClientOrderId *string
+	newClientOrderId  *string
+	recvWindow        *int64
+	timestamp         func() int64
+}
+
+// NewCancelOrderService creates a new CancelOrderService.
+func NewCancelOrderService(apiKey, secretKey string) *CancelOrderService {
+	return &CancelOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelOrderService) WithClient(client transport.HTTPClient) *CancelOrderService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the order.
+func (s *CancelOrderService) Symbol(symbol string) *CancelOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId sets the exchange order ID.
+func (s *CancelOrderService) OrderId(id string) *CancelOrderService {
+	s.orderId = &id
+	return s
+}
+
+// OrigClientOrderId sets the client order ID given when the order was placed.
+func (s *CancelOrderService) OrigClientOrderId(id string) *CancelOrderService {
+	s.origClientOrderId = &id
+	return s
+}
+
+// NewClientOrderId sets a unique ID for the cancellation.
+func (s *CancelOrderService) NewClientOrderId(id string) *CancelOrderService {
+	s.newClientOrderId = &id
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CancelOrderService) RecvWindow(ms int64) *CancelOrderService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelOrderService) Do(ctx context.Context) (*CanceledOrder, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodDelete).
+		WithPath("/api/v3/order").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CancelOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[CanceledOrder](resp.Body, op)
+}
+
+func (s *CancelOrderService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.orderId == nil && s.origClientOrderId == nil {
+		errs = append(errs, "either orderId or origClientOrderId is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelOrderService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.orderId != nil {
+		q.Add("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		q.Add("origClientOrderId", *s.origClientOrderId)
+	}
+	if s.newClientOrderId != nil {
+		q.Add("newClientOrderId", *s.newClientOrderId)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCancelOrderService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewCancelOrderService("", "").Symbol("BTCUSDT").OrderId("1").validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing order reference", func(t *testing.T) {
+		err := NewCancelOrderService("", "").Symbol("BTCUSDT").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "either orderId or origClientOrderId is required")
+	})
+}
+
+func TestCancelOrderService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewCancelOrderService("api-key", "secret").
+		Symbol("BTCUSDT").
+		OrigClientOrderId("abc").
+		NewClientOrderId("cancel-1")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+	assert.Empty(t, q.Get("orderId"))
+	assert.Equal(t, "abc", q.Get("origClientOrderId"))
+	assert.Equal(t, "cancel-1", q.Get("newClientOrderId"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCancelOrderService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewCancelOrderService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CancelOrderService.Validate", sdkErr.Op())
+}
+
+func TestCancelOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/order")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"symbol": "LTCBTC",
+					"origClientOrderId": "myOrder1",
+					"orderId": "4",
+					"clientOrderId": "cancelMyOrder1",
+					"price": "2.00000000",
+					"origQty": "1.00000000",
+					"executedQty": "0.00000000",
+					"cummulativeQuoteQty": "0.00000000",
+					"status": "CANCELED",
+					"timeInForce": "GTC",
+					"type": "LIMIT",
+					"side": "BUY"
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewCancelOrderService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("LTCBTC").
+		OrderId("4").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, "4", result.OrderID)
+	assert.Equal(t, "myOrder1", result.OrigClientOrderID)
+	assert.Equal(t, OrderStatusCanceled, result.Status)
+	testutil.AssertDecimalEqual(t, result.Price, "2", "price mismatch")
+}
@@ -42,6 +42,7 @@ type CreateOrderService struct {
 	price            *decimal.Decimal
 	newClientOrderId *string
 	recvWindow       *int64
+	testOrder        bool
 	timestamp        func() int64
 }
 
@@ -109,6 +110,14 @@ func (c *CreateOrderService) RecvWindow(ms int64) *CreateOrderService {
 	return c
 }
 
+// TestOrder sends the order to the test endpoint. The request is signed and
+// validated by the exchange, but no order is placed and the returned
+// PlacedOrder is empty.
+func (c *CreateOrderService) TestOrder(enabled bool) *CreateOrderService {
+	c.testOrder = enabled
+	return c
+}
+
 // Validate validates the service parameters.
 func (c *CreateOrderService) Validate() error {
 	if err := c.validate(); err != nil {
@@ -123,9 +132,14 @@ func (c *CreateOrderService) Validate() error {
 
 // Do executes the service.
 func (c *CreateOrderService) Do(ctx context.Context) (*PlacedOrder, error) {
+	path := "/api/v3/order"
+	if c.testOrder {
+		path = "/api/v3/order/test"
+	}
+
 	req := c.reqBuilder.
 		WithMethod(http.MethodPost).
-		WithPath("/api/v3/order").
+		WithPath(path).
 		WithQuery(c.buildQuery()).
 		Build()
 
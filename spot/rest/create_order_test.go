@@ -368,3 +368,35 @@ func TestCreateOrderService_Do_Errors(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateOrderService_Do_TestOrder(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/order/test")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.NotEmpty(t, query.Get("signature"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{}`),
+			}, nil
+		},
+	}
+
+	svc := NewCreateOrderService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("BTCUSDT").
+		Side(OrderSideBuy).
+		Type(OrderTypeLimit).
+		Quantity(decimal.NewFromFloat(0.1)).
+		Price(decimal.NewFromFloat(50000.0)).
+		TestOrder(true)
+
+	result, err := svc.Do(context.Background())
+
+	assert.NoError(t, err)
+	assert.NotNil(t, result)
+	assert.Empty(t, result.OrderID)
+}
@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// DefaultSymbolsService gets the symbols available for API trading.
+type DefaultSymbolsService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewDefaultSymbolsService creates a new DefaultSymbolsService.
+func NewDefaultSymbolsService() *DefaultSymbolsService {
+	return &DefaultSymbolsService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DefaultSymbolsService) WithClient(client transport.HTTPClient) *DefaultSymbolsService {
+	s.client = client
+	return s
+}
+
+// Do executes the service.
+func (s *DefaultSymbolsService) Do(ctx context.Context) ([]string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/defaultSymbols").
+		Build()
+
+	op := "DefaultSymbolsService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := decodeResponse[defaultSymbols](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return respObj.Data, nil
+}
+
+type defaultSymbols struct {
+	Data []string `json:"data"`
+}
@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDefaultSymbolsService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/defaultSymbols")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"code": 200, "data": ["BTCUSDT", "ETHUSDT"], "msg": null}`),
+			}, nil
+		},
+	}
+
+	result, err := NewDefaultSymbolsService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"BTCUSDT", "ETHUSDT"}, result)
+}
+
+func TestDefaultSymbolsService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 429,
+				Body:       []byte(`{"code": 429, "msg": "Too Many Requests"}`),
+			}, nil
+		},
+	}
+
+	result, err := NewDefaultSymbolsService().WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+}
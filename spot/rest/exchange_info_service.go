@@ -0,0 +1,150 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// ExchangeInfoService gets the trading rules and symbol information.
+type ExchangeInfoService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	symbols    []string
+}
+
+// NewExchangeInfoService creates a new ExchangeInfoService.
+func NewExchangeInfoService() *ExchangeInfoService {
+	return &ExchangeInfoService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ExchangeInfoService) WithClient(client transport.HTTPClient) *ExchangeInfoService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single symbol.
+func (s *ExchangeInfoService) Symbol(symbol string) *ExchangeInfoService {
+	s.symbol = symbol
+	return s
+}
+
+// Symbols limits the result to the given symbols.
+func (s *ExchangeInfoService) Symbols(symbols ...string) *ExchangeInfoService {
+	s.symbols = symbols
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *ExchangeInfoService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("ExchangeInfoService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *ExchangeInfoService) Do(ctx context.Context) (*ExchangeInfo, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/exchangeInfo").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "ExchangeInfoService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[ExchangeInfo](resp.Body, op)
+}
+
+func (s *ExchangeInfoService) validate() error {
+	var errs []string
+	if s.symbol != "" && len(s.symbols) > 0 {
+		errs = append(errs, "symbol and symbols cannot be used together")
+	}
+	for _, sym := range s.symbols {
+		if sym == "" {
+			errs = append(errs, "symbols cannot contain empty values")
+			break
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *ExchangeInfoService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != "" {
+		q.Add("symbol", s.symbol)
+	}
+	if len(s.symbols) > 0 {
+		q.Add("symbols", strings.Join(s.symbols, ","))
+	}
+	return q
+}
+
+// ExchangeInfo represents the exchange trading rules.
+type ExchangeInfo struct {
+	Timezone   string       `json:"timezone"`
+	ServerTime int64        `json:"serverTime"`
+	Symbols    []SymbolInfo `json:"symbols"`
+}
+
+// SymbolInfo represents the trading rules of a symbol.
+type SymbolInfo struct {
+	Symbol                     string          `json:"symbol"`
+	Status                     string          `json:"status"`
+	BaseAsset                  string          `json:"baseAsset"`
+	BaseAssetPrecision         int             `json:"baseAssetPrecision"`
+	QuoteAsset                 string          `json:"quoteAsset"`
+	QuotePrecision             int             `json:"quotePrecision"`
+	QuoteAssetPrecision        int             `json:"quoteAssetPrecision"`
+	BaseCommissionPrecision    int             `json:"baseCommissionPrecision"`
+	QuoteCommissionPrecision   int             `json:"quoteCommissionPrecision"`
+	OrderTypes                 []OrderType     `json:"orderTypes"`
+	IsSpotTradingAllowed       bool            `json:"isSpotTradingAllowed"`
+	IsMarginTradingAllowed     bool            `json:"isMarginTradingAllowed"`
+	QuoteAmountPrecision       decimal.Decimal `json:"quoteAmountPrecision"`
+	BaseSizePrecision          decimal.Decimal `json:"baseSizePrecision"`
+	Permissions                []string        `json:"permissions"`
+	MaxQuoteAmount             decimal.Decimal `json:"maxQuoteAmount"`
+	MakerCommission            decimal.Decimal `json:"makerCommission"`
+	TakerCommission            decimal.Decimal `json:"takerCommission"`
+	QuoteAmountPrecisionMarket decimal.Decimal `json:"quoteAmountPrecisionMarket"`
+	MaxQuoteAmountMarket       decimal.Decimal `json:"maxQuoteAmountMarket"`
+	FullName                   string          `json:"fullName"`
+	TradeSideType              int             `json:"tradeSideType"`
+}
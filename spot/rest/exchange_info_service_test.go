@@ -0,0 +1,123 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExchangeInfoService_validate(t *testing.T) {
+	t.Run("no filters", func(t *testing.T) {
+		err := NewExchangeInfoService().validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("symbol and symbols together", func(t *testing.T) {
+		svc := NewExchangeInfoService().Symbol("BTCUSDT").Symbols("ETHUSDT")
+		err := svc.validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol and symbols cannot be used together")
+	})
+
+	t.Run("empty symbol in list", func(t *testing.T) {
+		svc := NewExchangeInfoService().Symbols("BTCUSDT", "")
+		err := svc.validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbols cannot contain empty values")
+	})
+}
+
+func TestExchangeInfoService_buildQuery(t *testing.T) {
+	t.Run("symbol", func(t *testing.T) {
+		q := NewExchangeInfoService().Symbol("BTCUSDT").buildQuery()
+		assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+		assert.Empty(t, q.Get("symbols"))
+	})
+
+	t.Run("symbols", func(t *testing.T) {
+		q := NewExchangeInfoService().Symbols("BTCUSDT", "ETHUSDT").buildQuery()
+		assert.Equal(t, "BTCUSDT,ETHUSDT", q.Get("symbols"))
+		assert.Empty(t, q.Get("symbol"))
+	})
+}
+
+func TestExchangeInfoService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewExchangeInfoService().Symbol("BTCUSDT").Symbols("ETHUSDT").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "ExchangeInfoService.Validate", sdkErr.Op())
+}
+
+func TestExchangeInfoService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/exchangeInfo")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"timezone": "CST",
+					"serverTime": 1647950810000,
+					"rateLimits": [],
+					"exchangeFilters": [],
+					"symbols": [{
+						"symbol": "BTCUSDT",
+						"status": "1",
+						"baseAsset": "BTC",
+						"baseAssetPrecision": 6,
+						"quoteAsset": "USDT",
+						"quotePrecision": 2,
+						"quoteAssetPrecision": 2,
+						"baseCommissionPrecision": 6,
+						"quoteCommissionPrecision": 2,
+						"orderTypes": ["LIMIT", "MARKET", "LIMIT_MAKER"],
+						"isSpotTradingAllowed": true,
+						"isMarginTradingAllowed": false,
+						"quoteAmountPrecision": "1.000000000000000000000000000000",
+						"baseSizePrecision": "0",
+						"permissions": ["SPOT"],
+						"filters": [],
+						"maxQuoteAmount": "2000000.000000000000000000000000000000",
+						"makerCommission": "0",
+						"takerCommission": "0.0005",
+						"quoteAmountPrecisionMarket": "1.000000000000000000000000000000",
+						"maxQuoteAmountMarket": "100000.000000000000000000000000000000",
+						"fullName": "Bitcoin",
+						"tradeSideType": 1
+					}]
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewExchangeInfoService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, "CST", result.Timezone)
+	assert.Equal(t, int64(1647950810000), result.ServerTime)
+	require.Len(t, result.Symbols, 1)
+
+	sym := result.Symbols[0]
+	assert.Equal(t, "BTCUSDT", sym.Symbol)
+	assert.Equal(t, "BTC", sym.BaseAsset)
+	assert.Equal(t, 6, sym.BaseAssetPrecision)
+	assert.Equal(t, []OrderType{OrderTypeLimit, OrderTypeMarket, OrderTypeLimitMaker}, sym.OrderTypes)
+	assert.True(t, sym.IsSpotTradingAllowed)
+	assert.Equal(t, []string{"SPOT"}, sym.Permissions)
+	testutil.AssertDecimalEqual(t, sym.TakerCommission, "0.0005", "taker commission mismatch")
+	testutil.AssertDecimalEqual(t, sym.MaxQuoteAmount, "2000000", "max quote amount mismatch")
+}
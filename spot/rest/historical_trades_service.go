@@ -0,0 +1,117 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// HistoricalTradesService gets older trades for a symbol.
+type HistoricalTradesService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	limit      *int
+}
+
+// NewHistoricalTradesService creates a new HistoricalTradesService.
+// The endpoint is not signed but requires the API key header.
+func NewHistoricalTradesService(apiKey string) *HistoricalTradesService {
+	return &HistoricalTradesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *HistoricalTradesService) WithClient(client transport.HTTPClient) *HistoricalTradesService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the trades.
+func (s *HistoricalTradesService) Symbol(symbol string) *HistoricalTradesService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit sets the number of trades to return.
+func (s *HistoricalTradesService) Limit(n int) *HistoricalTradesService {
+	s.limit = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *HistoricalTradesService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("HistoricalTradesService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *HistoricalTradesService) Do(ctx context.Context) ([]Trade, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/historicalTrades").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "HistoricalTradesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	trades, err := decodeResponse[[]Trade](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *trades, nil
+}
+
+func (s *HistoricalTradesService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *HistoricalTradesService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	return q
+}
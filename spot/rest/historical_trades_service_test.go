@@ -0,0 +1,69 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoricalTradesService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewHistoricalTradesService("key").Symbol("BTCUSDT").Limit(1000).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewHistoricalTradesService("key").Limit(0).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "limit must be between 1 and 1000")
+	})
+}
+
+func TestHistoricalTradesService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewHistoricalTradesService("key").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "HistoricalTradesService.Validate", sdkErr.Op())
+}
+
+func TestHistoricalTradesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/historicalTrades")
+			assert.Equal(t, "key", req.Headers.Get("X-MEXC-APIKEY"))
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+			assert.Equal(t, "2", query.Get("limit"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[
+					{"id": null, "price": "100.5", "qty": "1", "quoteQty": "100.5", "time": 1, "isBuyerMaker": false, "isBestMatch": true},
+					{"id": null, "price": "100.6", "qty": "2", "quoteQty": "201.2", "time": 2, "isBuyerMaker": true, "isBestMatch": true}
+				]`),
+			}, nil
+		},
+	}
+
+	result, err := NewHistoricalTradesService("key").
+		WithClient(fakeClient).
+		Symbol("BTCUSDT").
+		Limit(2).
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	testutil.AssertDecimalEqual(t, result[1].QuoteQty, "201.2", "quote qty mismatch")
+}
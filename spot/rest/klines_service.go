@@ -0,0 +1,201 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// KlinesService gets the candlestick bars for a symbol.
+type KlinesService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	interval   KlineInterval
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+}
+
+// NewKlinesService creates a new KlinesService.
+func NewKlinesService() *KlinesService {
+	return &KlinesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *KlinesService) WithClient(client transport.HTTPClient) *KlinesService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the klines.
+func (s *KlinesService) Symbol(symbol string) *KlinesService {
+	s.symbol = symbol
+	return s
+}
+
+// Interval sets the kline interval.
+func (s *KlinesService) Interval(interval KlineInterval) *KlinesService {
+	s.interval = interval
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *KlinesService) StartTime(ms int64) *KlinesService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *KlinesService) EndTime(ms int64) *KlinesService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of klines to return.
+func (s *KlinesService) Limit(n int) *KlinesService {
+	s.limit = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *KlinesService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("KlinesService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *KlinesService) Do(ctx context.Context) ([]Kline, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/klines").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "KlinesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	klines, err := decodeResponse[[]Kline](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *klines, nil
+}
+
+func (s *KlinesService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if !s.interval.isValid() {
+		errs = append(errs, "interval is invalid")
+	}
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *KlinesService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	q.Add("interval", string(s.interval))
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	return q
+}
+
+// Kline represents a candlestick bar.
+type Kline struct {
+	OpenTime         int64
+	Open             decimal.Decimal
+	High             decimal.Decimal
+	Low              decimal.Decimal
+	Close            decimal.Decimal
+	Volume           decimal.Decimal
+	CloseTime        int64
+	QuoteAssetVolume decimal.Decimal
+}
+
+func (k *Kline) UnmarshalJSON(data []byte) error {
+	var tmp []json.RawMessage
+	if err := json.Unmarshal(data, &tmp); err != nil {
+		return err
+	}
+	if len(tmp) < 8 {
+		return fmt.Errorf("invalid kline length: %d", len(tmp))
+	}
+
+	if err := json.Unmarshal(tmp[0], &k.OpenTime); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[1], &k.Open); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[2], &k.High); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[3], &k.Low); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[4], &k.Close); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[5], &k.Volume); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[6], &k.CloseTime); err != nil {
+		return err
+	}
+	if err := json.Unmarshal(tmp[7], &k.QuoteAssetVolume); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,120 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKlinesService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewKlinesService().
+			Symbol("BTCUSDT").
+			Interval(Interval1h).
+			StartTime(1000).
+			EndTime(2000).
+			Limit(500).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		err := NewKlinesService().Symbol("BTCUSDT").Interval(KlineInterval("1h")).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "interval is invalid")
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewKlinesService().StartTime(2000).EndTime(1000).Limit(1001).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "interval is invalid")
+		assert.Contains(t, err.Error(), "startTime must not be after endTime")
+		assert.Contains(t, err.Error(), "limit must be between 1 and 1000")
+	})
+}
+
+func TestKlinesService_buildQuery(t *testing.T) {
+	q := NewKlinesService().
+		Symbol("BTCUSDT").
+		Interval(Interval1h).
+		StartTime(1000).
+		EndTime(2000).
+		Limit(10).
+		buildQuery()
+
+	assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+	assert.Equal(t, "60m", q.Get("interval"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "10", q.Get("limit"))
+}
+
+func TestKlinesService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewKlinesService().Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "KlinesService.Validate", sdkErr.Op())
+}
+
+func TestKlinesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/klines")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "1m", query.Get("interval"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[
+					[1640804880000, "47482.36", "47482.36", "47416.57", "47436.1", "3.550717", 1640804940000, "168387.3"]
+				]`),
+			}, nil
+		},
+	}
+
+	result, err := NewKlinesService().
+		WithClient(fakeClient).
+		Symbol("BTCUSDT").
+		Interval(Interval1m).
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	k := result[0]
+	assert.Equal(t, int64(1640804880000), k.OpenTime)
+	assert.Equal(t, int64(1640804940000), k.CloseTime)
+	testutil.AssertDecimalEqual(t, k.Open, "47482.36", "open mismatch")
+	testutil.AssertDecimalEqual(t, k.High, "47482.36", "high mismatch")
+	testutil.AssertDecimalEqual(t, k.Low, "47416.57", "low mismatch")
+	testutil.AssertDecimalEqual(t, k.Close, "47436.1", "close mismatch")
+	testutil.AssertDecimalEqual(t, k.Volume, "3.550717", "volume mismatch")
+	testutil.AssertDecimalEqual(t, k.QuoteAssetVolume, "168387.3", "quote volume mismatch")
+}
+
+func TestKline_UnmarshalJSON(t *testing.T) {
+	t.Run("invalid length", func(t *testing.T) {
+		var k Kline
+		err := json.Unmarshal([]byte(`[1640804880000, "1"]`), &k)
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid price", func(t *testing.T) {
+		var k Kline
+		err := json.Unmarshal([]byte(`[1, "x", "1", "1", "1", "1", 2, "1"]`), &k)
+		assert.Error(t, err)
+	})
+}
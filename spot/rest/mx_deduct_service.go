@@ -0,0 +1,237 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// MXDeductStatus represents whether trading fees are deducted in MX.
+type MXDeductStatus struct {
+	MXDeductEnable bool `json:"mxDeductEnable"`
+}
+
+type mxDeductResponse struct {
+	Data MXDeductStatus `json:"data"`
+}
+
+// MXDeductService gets the MX fee deduction status of the account.
+type MXDeductService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewMXDeductService creates a new MXDeductService.
+func NewMXDeductService(apiKey, secretKey string) *MXDeductService {
+	return &MXDeductService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *MXDeductService) WithClient(client transport.HTTPClient) *MXDeductService {
+	s.client = client
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *MXDeductService) RecvWindow(ms int64) *MXDeductService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *MXDeductService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("MXDeductService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *MXDeductService) Do(ctx context.Context) (*MXDeductStatus, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/mxDeduct/enable").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "MXDeductService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := decodeResponse[mxDeductResponse](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return &respObj.Data, nil
+}
+
+func (s *MXDeductService) validate() error {
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			return errors.New("recvWindow must be between 1 and 60000")
+		}
+	}
+	return nil
+}
+
+func (s *MXDeductService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// SetMXDeductService enables or disables MX fee deduction for the account.
+type SetMXDeductService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	enabled    bool
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewSetMXDeductService creates a new SetMXDeductService.
+func NewSetMXDeductService(apiKey, secretKey string) *SetMXDeductService {
+	return &SetMXDeductService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *SetMXDeductService) WithClient(client transport.HTTPClient) *SetMXDeductService {
+	s.client = client
+	return s
+}
+
+// Enabled sets whether trading fees should be deducted in MX.
+func (s *SetMXDeductService) Enabled(enabled bool) *SetMXDeductService {
+	s.enabled = enabled
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *SetMXDeductService) RecvWindow(ms int64) *SetMXDeductService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *SetMXDeductService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("SetMXDeductService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service and returns the resulting status.
+func (s *SetMXDeductService) Do(ctx context.Context) (*MXDeductStatus, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/mxDeduct/enable").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "SetMXDeductService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := decodeResponse[mxDeductResponse](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return &respObj.Data, nil
+}
+
+func (s *SetMXDeductService) validate() error {
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			return errors.New("recvWindow must be between 1 and 60000")
+		}
+	}
+	return nil
+}
+
+func (s *SetMXDeductService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("mxDeductEnable", strconv.FormatBool(s.enabled))
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
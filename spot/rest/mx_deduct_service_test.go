@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMXDeductService_validate(t *testing.T) {
+	assert.NoError(t, NewMXDeductService("", "").validate())
+
+	err := NewMXDeductService("", "").RecvWindow(0).validate()
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "recvWindow must be between 1 and 60000")
+}
+
+func TestMXDeductService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/mxDeduct/enable")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.False(t, query.Has("mxDeductEnable"))
+			assert.NotEmpty(t, query.Get("signature"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"mxDeductEnable":false},"code":0,"msg":"success","timestamp":1669109672280}`),
+			}, nil
+		},
+	}
+
+	result, err := NewMXDeductService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	require.NoError(t, err)
+	assert.False(t, result.MXDeductEnable)
+}
+
+func TestSetMXDeductService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/mxDeduct/enable")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "true", query.Get("mxDeductEnable"))
+			assert.NotEmpty(t, query.Get("signature"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"data":{"mxDeductEnable":true},"code":0,"msg":"success","timestamp":1669109672280}`),
+			}, nil
+		},
+	}
+
+	result, err := NewSetMXDeductService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Enabled(true).
+		Do(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.MXDeductEnable)
+}
+
+func TestSetMXDeductService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewSetMXDeductService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+	assert.Equal(t, "SetMXDeductService.Do", sdkErr.Op())
+}
@@ -0,0 +1,205 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// AccountTrade represents a fill of one of the account's orders.
+type AccountTrade struct {
+	Symbol          string          `json:"symbol"`
+	ID              string          `json:"id"`
+	OrderID         string          `json:"orderId"`
+	OrderListID     int64           `json:"orderListId"`
+	Price           decimal.Decimal `json:"price"`
+	Qty             decimal.Decimal `json:"qty"`
+	QuoteQty        decimal.Decimal `json:"quoteQty"`
+	Commission      decimal.Decimal `json:"commission"`
+	CommissionAsset string          `json:"commissionAsset"`
+	Time            int64           `json:"time"`
+	IsBuyer         bool            `json:"isBuyer"`
+	IsMaker         bool            `json:"isMaker"`
+	IsBestMatch     bool            `json:"isBestMatch"`
+	IsSelfTrade     bool            `json:"isSelfTrade"`
+	ClientOrderID   string          `json:"clientOrderId"`
+}
+
+// MyTradesService gets the trades of the account on a symbol.
+type MyTradesService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbol     string
+	orderId    *string
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewMyTradesService creates a new MyTradesService.
+func NewMyTradesService(apiKey, secretKey string) *MyTradesService {
+	return &MyTradesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *MyTradesService) WithClient(client transport.HTTPClient) *MyTradesService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the trades.
+func (s *MyTradesService) Symbol(symbol string) *MyTradesService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId limits the result to the fills of a single order.
+func (s *MyTradesService) OrderId(id string) *MyTradesService {
+	s.orderId = &id
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *MyTradesService) StartTime(ms int64) *MyTradesService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *MyTradesService) EndTime(ms int64) *MyTradesService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of trades to return.
+func (s *MyTradesService) Limit(n int) *MyTradesService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *MyTradesService) RecvWindow(ms int64) *MyTradesService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *MyTradesService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("MyTradesService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *MyTradesService) Do(ctx context.Context) ([]AccountTrade, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/myTrades").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "MyTradesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	trades, err := decodeResponse[[]AccountTrade](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *trades, nil
+}
+
+func (s *MyTradesService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 100) {
+		errs = append(errs, "limit must be between 1 and 100")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *MyTradesService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.orderId != nil {
+		q.Add("orderId", *s.orderId)
+	}
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
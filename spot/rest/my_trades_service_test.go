@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMyTradesService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewMyTradesService("", "").
+			Symbol("BTCUSDT").
+			OrderId("1").
+			StartTime(1000).
+			EndTime(2000).
+			Limit(100).
+			validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewMyTradesService("", "").
+			StartTime(2000).
+			EndTime(1000).
+			Limit(101).
+			RecvWindow(0).
+			validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "startTime must not be after endTime")
+		assert.Contains(t, err.Error(), "limit must be between 1 and 100")
+		assert.Contains(t, err.Error(), "recvWindow must be between 1 and 60000")
+	})
+}
+
+func TestMyTradesService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewMyTradesService("api-key", "secret").
+		Symbol("BTCUSDT").
+		OrderId("42").
+		StartTime(1000).
+		EndTime(2000).
+		Limit(50).
+		RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+	assert.Equal(t, "42", q.Get("orderId"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "50", q.Get("limit"))
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestMyTradesService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewMyTradesService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "MyTradesService.Validate", sdkErr.Op())
+}
+
+func TestMyTradesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/myTrades")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[{
+					"symbol": "MXUSDT",
+					"id": "fad2af9e942049b6adbda1a271f990c6",
+					"orderId": "bb41e5663e124046bd9497a3f5692f39",
+					"orderListId": -1,
+					"price": "3.4",
+					"qty": "0.9",
+					"quoteQty": "3.06",
+					"commission": "0.00306",
+					"commissionAsset": "USDT",
+					"time": 1630320373000,
+					"isBuyer": false,
+					"isMaker": false,
+					"isBestMatch": true,
+					"isSelfTrade": true,
+					"clientOrderId": null
+				}]`),
+			}, nil
+		},
+	}
+
+	result, err := NewMyTradesService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("MXUSDT").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	tr := result[0]
+	assert.Equal(t, "fad2af9e942049b6adbda1a271f990c6", tr.ID)
+	assert.Equal(t, "bb41e5663e124046bd9497a3f5692f39", tr.OrderID)
+	assert.Equal(t, "USDT", tr.CommissionAsset)
+	assert.True(t, tr.IsSelfTrade)
+	assert.Empty(t, tr.ClientOrderID)
+	testutil.AssertDecimalEqual(t, tr.Commission, "0.00306", "commission mismatch")
+	testutil.AssertDecimalEqual(t, tr.QuoteQty, "3.06", "quote qty mismatch")
+}
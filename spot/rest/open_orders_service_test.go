@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenOrdersService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewOpenOrdersService("", "").Symbol("BTCUSDT").RecvWindow(5000).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing symbol", func(t *testing.T) {
+		err := NewOpenOrdersService("", "").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+	})
+}
+
+func TestOpenOrdersService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/openOrders")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`[` + orderJSON + `]`)}, nil
+		},
+	}
+
+	result, err := NewOpenOrdersService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("LTCBTC").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, OrderStatusPartiallyFilled, result[0].Status)
+}
+
+func TestOpenOrdersService_Do_DecodeError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`[{"status": "UNKNOWN"}]`),
+			}, nil
+		},
+	}
+
+	result, err := NewOpenOrdersService("", "").WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
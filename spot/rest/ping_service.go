@@ -0,0 +1,58 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// PingService tests connectivity to the REST API.
+type PingService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewPingService creates a new PingService.
+func NewPingService() *PingService {
+	return &PingService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *PingService) WithClient(client transport.HTTPClient) *PingService {
+	s.client = client
+	return s
+}
+
+// Do executes the service.
+func (s *PingService) Do(ctx context.Context) error {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/ping").
+		Build()
+
+	op := "PingService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPingService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/ping")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{}`),
+			}, nil
+		},
+	}
+
+	err := NewPingService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+}
+
+func TestPingService_Do_Errors(t *testing.T) {
+	type testCase struct {
+		name     string
+		setup    func() transport.HTTPClient
+		wantKind error
+	}
+
+	cases := []testCase{
+		{
+			name: "client fails",
+			setup: func() transport.HTTPClient {
+				return &testutil.FakeHTTPClient{
+					DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+						return nil, errors.New("network is down")
+					},
+				}
+			},
+			wantKind: sdkerr.ErrRequestFailed,
+		},
+		{
+			name: "bad status",
+			setup: func() transport.HTTPClient {
+				return &testutil.FakeHTTPClient{
+					DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+						return &transport.Response{
+							StatusCode: 503,
+							Body:       []byte(`service unavailable`),
+						}, nil
+					},
+				}
+			},
+			wantKind: sdkerr.ErrAPIError,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := NewPingService().WithClient(tc.setup()).Do(context.Background())
+			assert.Error(t, err)
+
+			var sdkErr *sdkerr.SDKError
+			assert.ErrorAs(t, err, &sdkErr)
+			assert.Equal(t, tc.wantKind, sdkErr.Kind())
+		})
+	}
+}
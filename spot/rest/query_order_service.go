@@ -0,0 +1,180 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Order represents an order as returned by the order query endpoints.
+type Order struct {
+	Symbol              string          `json:"symbol"`
+	OrderID             string          `json:"orderId"`
+	OrderListID         int64           `json:"orderListId"`
+	ClientOrderID       string          `json:"clientOrderId"`
+	Price               decimal.Decimal `json:"price"`
+	OrigQty             decimal.Decimal `json:"origQty"`
+	ExecutedQty         decimal.Decimal `json:"executedQty"`
+	CummulativeQuoteQty decimal.Decimal `json:"cummulativeQuoteQty"`
+	Status              OrderStatus     `json:"status"`
+	TimeInForce         string          `json:"timeInForce"`
+	Type                OrderType       `json:"type"`
+	Side                OrderSide       `json:"side"`
+	StopPrice           decimal.Decimal `json:"stopPrice"`
+	IcebergQty          decimal.Decimal `json:"icebergQty"`
+	Time                int64           `json:"time"`
+	UpdateTime          int64           `json:"updateTime"`
+	IsWorking           bool            `json:"isWorking"`
+	OrigQuoteOrderQty   decimal.Decimal `json:"origQuoteOrderQty"`
+}
+
+// QueryOrderService gets a single order by orderId or origClientOrderId.
+type QueryOrderService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbol            string
+	orderId           *string
+	origClientOrderId *string
+	recvWindow        *int64
+	timestamp         func() int64
+}
+
+// NewQueryOrderService creates a new QueryOrderService.
+func NewQueryOrderService(apiKey, secretKey string) *QueryOrderService {
+	return &QueryOrderService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *QueryOrderService) WithClient(client transport.HTTPClient) *QueryOrderService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the order.
+func (s *QueryOrderService) Symbol(symbol string) *QueryOrderService {
+	s.symbol = symbol
+	return s
+}
+
+// OrderId sets the exchange order ID.
+func (s *QueryOrderService) OrderId(id string) *QueryOrderService {
+	s.orderId = &id
+	return s
+}
+
+// OrigClientOrderId sets the client order ID given when the order was placed.
+func (s *QueryOrderService) OrigClientOrderId(id string) *QueryOrderService {
+	s.origClientOrderId = &id
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *QueryOrderService) RecvWindow(ms int64) *QueryOrderService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *QueryOrderService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("QueryOrderService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *QueryOrderService) Do(ctx context.Context) (*Order, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/order").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "QueryOrderService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeResponse[Order](resp.Body, op)
+}
+
+func (s *QueryOrderService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.orderId == nil && s.origClientOrderId == nil {
+		errs = append(errs, "either orderId or origClientOrderId is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *QueryOrderService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.orderId != nil {
+		q.Add("orderId", *s.orderId)
+	}
+	if s.origClientOrderId != nil {
+		q.Add("origClientOrderId", *s.origClientOrderId)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
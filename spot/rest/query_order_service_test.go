@@ -0,0 +1,146 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const orderJSON = `{
+	"symbol": "LTCBTC",
+	"orderId": "C02__443776347957968896",
+	"orderListId": -1,
+	"clientOrderId": "myOrder1",
+	"price": "0.1",
+	"origQty": "1.0",
+	"executedQty": "0.4",
+	"cummulativeQuoteQty": "0.04",
+	"status": "PARTIALLY_FILLED",
+	"timeInForce": "",
+	"type": "LIMIT",
+	"side": "BUY",
+	"stopPrice": "0.0",
+	"icebergQty": "0.0",
+	"time": 1499827319559,
+	"updateTime": 1499827319600,
+	"isWorking": true,
+	"origQuoteOrderQty": "0.000000"
+}`
+
+func TestQueryOrderService_validate(t *testing.T) {
+	t.Run("by orderId", func(t *testing.T) {
+		err := NewQueryOrderService("", "").Symbol("BTCUSDT").OrderId("1").validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("by origClientOrderId", func(t *testing.T) {
+		err := NewQueryOrderService("", "").Symbol("BTCUSDT").OrigClientOrderId("abc").validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("multiple errors", func(t *testing.T) {
+		err := NewQueryOrderService("", "").RecvWindow(0).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+		assert.Contains(t, err.Error(), "either orderId or origClientOrderId is required")
+		assert.Contains(t, err.Error(), "recvWindow must be between 1 and 60000")
+	})
+}
+
+func TestQueryOrderService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewQueryOrderService("api-key", "secret").
+		Symbol("BTCUSDT").
+		OrderId("123").
+		OrigClientOrderId("abc").
+		RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "BTCUSDT", q.Get("symbol"))
+	assert.Equal(t, "123", q.Get("orderId"))
+	assert.Equal(t, "abc", q.Get("origClientOrderId"))
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestQueryOrderService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/order")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "C02__443776347957968896", query.Get("orderId"))
+			assert.NotEmpty(t, query.Get("signature"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(orderJSON)}, nil
+		},
+	}
+
+	result, err := NewQueryOrderService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("LTCBTC").
+		OrderId("C02__443776347957968896").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+
+	assert.Equal(t, "LTCBTC", result.Symbol)
+	assert.Equal(t, int64(-1), result.OrderListID)
+	assert.Equal(t, "myOrder1", result.ClientOrderID)
+	assert.Equal(t, OrderStatusPartiallyFilled, result.Status)
+	assert.Equal(t, OrderTypeLimit, result.Type)
+	assert.Equal(t, OrderSideBuy, result.Side)
+	assert.Equal(t, int64(1499827319600), result.UpdateTime)
+	assert.True(t, result.IsWorking)
+	testutil.AssertDecimalEqual(t, result.ExecutedQty, "0.4", "executed qty mismatch")
+	testutil.AssertDecimalEqual(t, result.CummulativeQuoteQty, "0.04", "cumulative quote qty mismatch")
+}
+
+func TestQueryOrderService_Do_Errors(t *testing.T) {
+	t.Run("client fails", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				return nil, errors.New("network is down")
+			},
+		}
+
+		result, err := NewQueryOrderService("", "").WithClient(fakeClient).Symbol("BTCUSDT").OrderId("1").Do(context.Background())
+		assert.Nil(t, result)
+
+		var sdkErr *sdkerr.SDKError
+		assert.ErrorAs(t, err, &sdkErr)
+		assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+	})
+
+	t.Run("unknown order", func(t *testing.T) {
+		fakeClient := &testutil.FakeHTTPClient{
+			DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+				return &transport.Response{
+					StatusCode: 400,
+					Body:       []byte(`{"code": -2011, "msg": "Unknown order sent"}`),
+				}, nil
+			},
+		}
+
+		result, err := NewQueryOrderService("", "").WithClient(fakeClient).Symbol("BTCUSDT").OrderId("1").Do(context.Background())
+		assert.Nil(t, result)
+		assert.ErrorIs(t, err, sdkerr.ErrAPIError)
+		assert.ErrorIs(t, err, errs.ErrUnknownOrderSent)
+	})
+}
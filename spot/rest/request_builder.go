@@ -42,7 +42,7 @@ func (s *requestBuilder) WithBody(body []byte) *requestBuilder {
 }
 
 func (s *requestBuilder) Build() *transport.Request {
-	if s.inner.Method != http.MethodGet {
+	if s.inner.Method != http.MethodGet || s.apiKey != "" {
 		headers := buildHeaders(s.apiKey)
 		s.inner.WithHeaders(headers)
 	}
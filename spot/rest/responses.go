@@ -35,3 +35,21 @@ func decodeResponse[T any](data []byte, op string) (*T, error) {
 	}
 	return &result, nil
 }
+
+// decodeOneOrMany decodes endpoints that return a single object when a symbol
+// is given and an array otherwise.
+func decodeOneOrMany[T any](data []byte, single bool, op string) ([]T, error) {
+	if single {
+		item, err := decodeResponse[T](data, op)
+		if err != nil {
+			return nil, err
+		}
+		return []T{*item}, nil
+	}
+
+	items, err := decodeResponse[[]T](data, op)
+	if err != nil {
+		return nil, err
+	}
+	return *items, nil
+}
@@ -0,0 +1,66 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// ServerTimeService gets the current server time.
+type ServerTimeService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+}
+
+// NewServerTimeService creates a new ServerTimeService.
+func NewServerTimeService() *ServerTimeService {
+	return &ServerTimeService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *ServerTimeService) WithClient(client transport.HTTPClient) *ServerTimeService {
+	s.client = client
+	return s
+}
+
+// Do executes the service and returns the server time in milliseconds.
+func (s *ServerTimeService) Do(ctx context.Context) (int64, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/time").
+		Build()
+
+	op := "ServerTimeService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return 0, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return 0, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := decodeResponse[serverTime](resp.Body, op)
+	if err != nil {
+		return 0, err
+	}
+	return respObj.ServerTime, nil
+}
+
+type serverTime struct {
+	ServerTime int64 `json:"serverTime"`
+}
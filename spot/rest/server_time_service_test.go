@@ -0,0 +1,48 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestServerTimeService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/time")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"serverTime": 1645539742000}`),
+			}, nil
+		},
+	}
+
+	result, err := NewServerTimeService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1645539742000), result)
+}
+
+func TestServerTimeService_Do_DecodeError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{invalid json}`),
+			}, nil
+		},
+	}
+
+	result, err := NewServerTimeService().WithClient(fakeClient).Do(context.Background())
+	assert.Zero(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
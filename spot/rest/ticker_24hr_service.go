@@ -0,0 +1,98 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Ticker24hrService gets the 24 hour rolling window price change statistics.
+// If no symbol is set, statistics for all symbols are returned.
+type Ticker24hrService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewTicker24hrService creates a new Ticker24hrService.
+func NewTicker24hrService() *Ticker24hrService {
+	return &Ticker24hrService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *Ticker24hrService) WithClient(client transport.HTTPClient) *Ticker24hrService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single symbol.
+func (s *Ticker24hrService) Symbol(symbol string) *Ticker24hrService {
+	s.symbol = symbol
+	return s
+}
+
+// Do executes the service.
+func (s *Ticker24hrService) Do(ctx context.Context) ([]Ticker24hr, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/ticker/24hr").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "Ticker24hrService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeOneOrMany[Ticker24hr](resp.Body, s.symbol != "", op)
+}
+
+func (s *Ticker24hrService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != "" {
+		q.Add("symbol", s.symbol)
+	}
+	return q
+}
+
+// Ticker24hr represents 24 hour price change statistics.
+type Ticker24hr struct {
+	Symbol             string          `json:"symbol"`
+	PriceChange        decimal.Decimal `json:"priceChange"`
+	PriceChangePercent decimal.Decimal `json:"priceChangePercent"`
+	PrevClosePrice     decimal.Decimal `json:"prevClosePrice"`
+	LastPrice          decimal.Decimal `json:"lastPrice"`
+	BidPrice           decimal.Decimal `json:"bidPrice"`
+	BidQty             decimal.Decimal `json:"bidQty"`
+	AskPrice           decimal.Decimal `json:"askPrice"`
+	AskQty             decimal.Decimal `json:"askQty"`
+	OpenPrice          decimal.Decimal `json:"openPrice"`
+	HighPrice          decimal.Decimal `json:"highPrice"`
+	LowPrice           decimal.Decimal `json:"lowPrice"`
+	Volume             decimal.Decimal `json:"volume"`
+	QuoteVolume        decimal.Decimal `json:"quoteVolume"`
+	OpenTime           int64           `json:"openTime"`
+	CloseTime          int64           `json:"closeTime"`
+	Count              int64           `json:"count"`
+}
@@ -0,0 +1,74 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const ticker24hrJSON = `{
+	"symbol": "BTCUSDT",
+	"priceChange": "184.34",
+	"priceChangePercent": "0.00400048",
+	"prevClosePrice": "46079.37",
+	"lastPrice": "46263.71",
+	"bidPrice": "46260.38",
+	"bidQty": "0.5",
+	"askPrice": "46260.41",
+	"askQty": "1.25",
+	"openPrice": "46079.37",
+	"highPrice": "47550.01",
+	"lowPrice": "45555.5",
+	"volume": "1732.461487",
+	"quoteVolume": null,
+	"openTime": 1641349500000,
+	"closeTime": 1641349582808,
+	"count": null
+}`
+
+func TestTicker24hrService_Do_SingleSymbol(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/ticker/24hr")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "BTCUSDT", query.Get("symbol"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(ticker24hrJSON)}, nil
+		},
+	}
+
+	result, err := NewTicker24hrService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	assert.Equal(t, "BTCUSDT", result[0].Symbol)
+	assert.Equal(t, int64(1641349582808), result[0].CloseTime)
+	testutil.AssertDecimalEqual(t, result[0].LastPrice, "46263.71", "last price mismatch")
+	testutil.AssertDecimalEqual(t, result[0].PriceChangePercent, "0.00400048", "change percent mismatch")
+	assert.True(t, result[0].QuoteVolume.IsZero())
+}
+
+func TestTicker24hrService_Do_AllSymbols(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Empty(t, query.Get("symbol"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`[` + ticker24hrJSON + `,` + ticker24hrJSON + `]`),
+			}, nil
+		},
+	}
+
+	result, err := NewTicker24hrService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, result, 2)
+}
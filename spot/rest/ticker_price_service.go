@@ -0,0 +1,82 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// TickerPriceService gets the latest price for a symbol or all symbols.
+type TickerPriceService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+}
+
+// NewTickerPriceService creates a new TickerPriceService.
+func NewTickerPriceService() *TickerPriceService {
+	return &TickerPriceService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TickerPriceService) WithClient(client transport.HTTPClient) *TickerPriceService {
+	s.client = client
+	return s
+}
+
+// Symbol limits the result to a single symbol.
+func (s *TickerPriceService) Symbol(symbol string) *TickerPriceService {
+	s.symbol = symbol
+	return s
+}
+
+// Do executes the service.
+func (s *TickerPriceService) Do(ctx context.Context) ([]TickerPrice, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/ticker/price").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TickerPriceService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return decodeOneOrMany[TickerPrice](resp.Body, s.symbol != "", op)
+}
+
+func (s *TickerPriceService) buildQuery() url.Values {
+	q := make(url.Values)
+	if s.symbol != "" {
+		q.Add("symbol", s.symbol)
+	}
+	return q
+}
+
+// TickerPrice represents the latest price of a symbol.
+type TickerPrice struct {
+	Symbol string          `json:"symbol"`
+	Price  decimal.Decimal `json:"price"`
+}
@@ -0,0 +1,71 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTickerPriceService_Do_SingleSymbol(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/ticker/price")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"symbol": "BTCUSDT", "price": "184.34"}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTickerPriceService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	assert.Equal(t, "BTCUSDT", result[0].Symbol)
+	testutil.AssertDecimalEqual(t, result[0].Price, "184.34", "price mismatch")
+}
+
+func TestTickerPriceService_Do_AllSymbols(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[
+					{"symbol": "BTCUSDT", "price": "60000"},
+					{"symbol": "ETHUSDT", "price": "3000"}
+				]`),
+			}, nil
+		},
+	}
+
+	result, err := NewTickerPriceService().WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "ETHUSDT", result[1].Symbol)
+}
+
+func TestTickerPriceService_Do_ShapeMismatch(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{
+				StatusCode: 200,
+				Body:       []byte(`{"symbol": "BTCUSDT", "price": "60000"}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTickerPriceService().WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
@@ -0,0 +1,148 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// TradeFee represents the commission rates of the account on a symbol.
+type TradeFee struct {
+	MakerCommission decimal.Decimal `json:"makerCommission"`
+	TakerCommission decimal.Decimal `json:"takerCommission"`
+}
+
+type tradeFeeResponse struct {
+	Data TradeFee `json:"data"`
+}
+
+// TradeFeeService gets the commission rates for a symbol.
+type TradeFeeService struct {
+	secretKey  string
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+
+	symbol     string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewTradeFeeService creates a new TradeFeeService.
+func NewTradeFeeService(apiKey, secretKey string) *TradeFeeService {
+	return &TradeFeeService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TradeFeeService) WithClient(client transport.HTTPClient) *TradeFeeService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol of the commission rates.
+func (s *TradeFeeService) Symbol(symbol string) *TradeFeeService {
+	s.symbol = symbol
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *TradeFeeService) RecvWindow(ms int64) *TradeFeeService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *TradeFeeService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("TradeFeeService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *TradeFeeService) Do(ctx context.Context) (*TradeFee, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/tradeFee").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TradeFeeService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := decodeResponse[tradeFeeResponse](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return &respObj.Data, nil
+}
+
+func (s *TradeFeeService) validate() error {
+	var errs []string
+
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *TradeFeeService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("symbol", s.symbol)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,77 @@
+package rest
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradeFeeService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewTradeFeeService("", "").Symbol("BTCUSDT").validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing symbol", func(t *testing.T) {
+		err := NewTradeFeeService("", "").validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+	})
+}
+
+func TestTradeFeeService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/tradeFee")
+
+			query := testutil.ExtractQuery(t, req.FullURL)
+			assert.Equal(t, "MXUSDT", query.Get("symbol"))
+			assert.NotEmpty(t, query.Get("signature"))
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`{
+					"data": {
+						"makerCommission": 0.003000000000000000,
+						"takerCommission": 0.003000000000000000
+					},
+					"code": 0,
+					"msg": "success",
+					"timestamp": 1669109672717
+				}`),
+			}, nil
+		},
+	}
+
+	result, err := NewTradeFeeService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Symbol("MXUSDT").
+		Do(context.Background())
+
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	testutil.AssertDecimalEqual(t, result.MakerCommission, "0.003", "maker commission mismatch")
+	testutil.AssertDecimalEqual(t, result.TakerCommission, "0.003", "taker commission mismatch")
+}
+
+func TestTradeFeeService_Do_DecodeError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"data": []}`)}, nil
+		},
+	}
+
+	result, err := NewTradeFeeService("", "").WithClient(fakeClient).Symbol("MXUSDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrDecodeError, sdkErr.Kind())
+}
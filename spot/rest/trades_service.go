@@ -0,0 +1,128 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// TradesService gets the recent trades for a symbol.
+type TradesService struct {
+	client     transport.HTTPClient
+	reqBuilder *requestBuilder
+	symbol     string
+	limit      *int
+}
+
+// NewTradesService creates a new TradesService.
+func NewTradesService() *TradesService {
+	return &TradesService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: newRequestBuilder(""),
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TradesService) WithClient(client transport.HTTPClient) *TradesService {
+	s.client = client
+	return s
+}
+
+// Symbol sets the symbol for the trades.
+func (s *TradesService) Symbol(symbol string) *TradesService {
+	s.symbol = symbol
+	return s
+}
+
+// Limit sets the number of trades to return.
+func (s *TradesService) Limit(n int) *TradesService {
+	s.limit = &n
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *TradesService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("TradesService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *TradesService) Do(ctx context.Context) ([]Trade, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/trades").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TradesService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := checkResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	trades, err := decodeResponse[[]Trade](resp.Body, op)
+	if err != nil {
+		return nil, err
+	}
+	return *trades, nil
+}
+
+func (s *TradesService) validate() error {
+	var errs []string
+	if s.symbol == "" {
+		errs = append(errs, "symbol is required")
+	}
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *TradesService) buildQuery() url.Values {
+	q := make(url.Values)
+	q.Add("symbol", s.symbol)
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	return q
+}
+
+// Trade represents a public trade.
+type Trade struct {
+	ID           int64           `json:"id"`
+	Price        decimal.Decimal `json:"price"`
+	Qty          decimal.Decimal `json:"qty"`
+	QuoteQty     decimal.Decimal `json:"quoteQty"`
+	Time         int64           `json:"time"`
+	IsBuyerMaker bool            `json:"isBuyerMaker"`
+	IsBestMatch  bool            `json:"isBestMatch"`
+}
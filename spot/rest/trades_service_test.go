@@ -0,0 +1,86 @@
+package rest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTradesService_validate(t *testing.T) {
+	t.Run("valid config", func(t *testing.T) {
+		err := NewTradesService().Symbol("BTCUSDT").Limit(100).validate()
+		assert.NoError(t, err)
+	})
+
+	t.Run("missing symbol", func(t *testing.T) {
+		err := NewTradesService().validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required")
+	})
+
+	t.Run("limit out of range", func(t *testing.T) {
+		err := NewTradesService().Symbol("BTCUSDT").Limit(1001).validate()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "limit must be between 1 and 1000")
+	})
+}
+
+func TestTradesService_buildQuery(t *testing.T) {
+	q := NewTradesService().Symbol("ETHUSDT").Limit(50).buildQuery()
+	assert.Equal(t, "ETHUSDT", q.Get("symbol"))
+	assert.Equal(t, "50", q.Get("limit"))
+}
+
+func TestTradesService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/trades")
+
+			return &transport.Response{
+				StatusCode: 200,
+				Body: []byte(`[{
+					"id": null,
+					"price": "23",
+					"qty": "0.478468",
+					"quoteQty": "10.999999",
+					"time": 1640830579240,
+					"isBuyerMaker": true,
+					"isBestMatch": true
+				}]`),
+			}, nil
+		},
+	}
+
+	result, err := NewTradesService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	assert.Equal(t, int64(1640830579240), result[0].Time)
+	assert.True(t, result[0].IsBuyerMaker)
+	testutil.AssertDecimalEqual(t, result[0].Price, "23", "price mismatch")
+	testutil.AssertDecimalEqual(t, result[0].Qty, "0.478468", "qty mismatch")
+	testutil.AssertDecimalEqual(t, result[0].QuoteQty, "10.999999", "quote qty mismatch")
+}
+
+func TestTradesService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewTradesService().WithClient(fakeClient).Symbol("BTCUSDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
@@ -0,0 +1,460 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+const maxAPIKeyIPs = 20
+
+// CreatedAPIKey represents a newly created sub-account API key.
+// SecretKey is only returned on creation.
+type CreatedAPIKey struct {
+	SubAccount  string      `json:"subAccount"`
+	Note        string      `json:"note"`
+	APIKey      string      `json:"apiKey"`
+	SecretKey   string      `json:"secretKey"`
+	Permissions Permissions `json:"permissions"`
+	IP          string      `json:"ip"`
+	CreateTime  int64       `json:"creatTime"`
+}
+
+// APIKey represents an API key of a sub-account.
+type APIKey struct {
+	Note        string      `json:"note"`
+	APIKey      string      `json:"apiKey"`
+	Permissions Permissions `json:"permissions"`
+	IP          string      `json:"ip"`
+	CreateTime  int64       `json:"creatTime"`
+}
+
+type apiKeyListResponse struct {
+	SubAccount []APIKey `json:"subAccount"`
+}
+
+type deleteAPIKeyResponse struct {
+	SubAccount string `json:"subAccount"`
+}
+
+// CreateAPIKeyService creates an API key for a sub-account.
+type CreateAPIKeyService struct {
+	client      transport.HTTPClient
+	reqBuilder  *spotapi.RequestBuilder
+	secretKey   string
+	subAccount  string
+	note        string
+	permissions []Permission
+	ips         []string
+	recvWindow  *int64
+	timestamp   func() int64
+}
+
+// NewCreateAPIKeyService creates a new CreateAPIKeyService.
+func NewCreateAPIKeyService(apiKey, secretKey string) *CreateAPIKeyService {
+	return &CreateAPIKeyService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CreateAPIKeyService) WithClient(client transport.HTTPClient) *CreateAPIKeyService {
+	s.client = client
+	return s
+}
+
+// SubAccount sets the name of the sub-account.
+func (s *CreateAPIKeyService) SubAccount(name string) *CreateAPIKeyService {
+	s.subAccount = name
+	return s
+}
+
+// Note sets the note of the API key.
+func (s *CreateAPIKeyService) Note(note string) *CreateAPIKeyService {
+	s.note = note
+	return s
+}
+
+// Permissions sets the permissions of the API key.
+func (s *CreateAPIKeyService) Permissions(permissions ...Permission) *CreateAPIKeyService {
+	s.permissions = permissions
+	return s
+}
+
+// IPs restricts the API key to the given IP addresses (max 20).
+func (s *CreateAPIKeyService) IPs(ips ...string) *CreateAPIKeyService {
+	s.ips = ips
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CreateAPIKeyService) RecvWindow(ms int64) *CreateAPIKeyService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CreateAPIKeyService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CreateAPIKeyService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CreateAPIKeyService) Do(ctx context.Context) (*CreatedAPIKey, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/sub-account/apiKey").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CreateAPIKeyService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[CreatedAPIKey](resp.Body, subsys, op)
+}
+
+func (s *CreateAPIKeyService) validate() error {
+	var errs []string
+
+	if s.subAccount == "" {
+		errs = append(errs, "subAccount is required")
+	}
+
+	if s.note == "" {
+		errs = append(errs, "note is required")
+	}
+
+	if len(s.permissions) == 0 {
+		errs = append(errs, "at least one permission is required")
+	}
+	for _, p := range s.permissions {
+		if !p.isValid() {
+			errs = append(errs, fmt.Sprintf("invalid permission: %s", p))
+		}
+	}
+
+	if len(s.ips) > maxAPIKeyIPs {
+		errs = append(errs, fmt.Sprintf("at most %d IPs are allowed", maxAPIKeyIPs))
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CreateAPIKeyService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("subAccount", s.subAccount)
+	q.Add("note", s.note)
+	q.Add("permissions", Permissions(s.permissions).String())
+
+	if len(s.ips) > 0 {
+		q.Add("ip", strings.Join(s.ips, ","))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// APIKeyService lists the API keys of a sub-account.
+type APIKeyService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	subAccount string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewAPIKeyService creates a new APIKeyService.
+func NewAPIKeyService(apiKey, secretKey string) *APIKeyService {
+	return &APIKeyService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *APIKeyService) WithClient(client transport.HTTPClient) *APIKeyService {
+	s.client = client
+	return s
+}
+
+// SubAccount sets the name of the sub-account.
+func (s *APIKeyService) SubAccount(name string) *APIKeyService {
+	s.subAccount = name
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *APIKeyService) RecvWindow(ms int64) *APIKeyService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *APIKeyService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("APIKeyService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *APIKeyService) Do(ctx context.Context) ([]APIKey, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/sub-account/apiKey").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "APIKeyService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := spotapi.DecodeResponse[apiKeyListResponse](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return respObj.SubAccount, nil
+}
+
+func (s *APIKeyService) validate() error {
+	var errs []string
+
+	if s.subAccount == "" {
+		errs = append(errs, "subAccount is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *APIKeyService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("subAccount", s.subAccount)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// DeleteAPIKeyService deletes an API key of a sub-account.
+type DeleteAPIKeyService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	subAccount string
+	apiKey     string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDeleteAPIKeyService creates a new DeleteAPIKeyService.
+func NewDeleteAPIKeyService(apiKey, secretKey string) *DeleteAPIKeyService {
+	return &DeleteAPIKeyService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DeleteAPIKeyService) WithClient(client transport.HTTPClient) *DeleteAPIKeyService {
+	s.client = client
+	return s
+}
+
+// SubAccount sets the name of the sub-account.
+func (s *DeleteAPIKeyService) SubAccount(name string) *DeleteAPIKeyService {
+	s.subAccount = name
+	return s
+}
+
+// APIKey sets the API key to delete.
+func (s *DeleteAPIKeyService) APIKey(key string) *DeleteAPIKeyService {
+	s.apiKey = key
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DeleteAPIKeyService) RecvWindow(ms int64) *DeleteAPIKeyService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DeleteAPIKeyService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DeleteAPIKeyService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service and returns the name of the sub-account.
+func (s *DeleteAPIKeyService) Do(ctx context.Context) (string, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodDelete).
+		WithPath("/api/v3/sub-account/apiKey").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DeleteAPIKeyService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return "", sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := spotapi.DecodeResponse[deleteAPIKeyResponse](resp.Body, subsys, op)
+	if err != nil {
+		return "", err
+	}
+	return respObj.SubAccount, nil
+}
+
+func (s *DeleteAPIKeyService) validate() error {
+	var errs []string
+
+	if s.subAccount == "" {
+		errs = append(errs, "subAccount is required")
+	}
+
+	if s.apiKey == "" {
+		errs = append(errs, "apiKey is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DeleteAPIKeyService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("subAccount", s.subAccount)
+	q.Add("apiKey", s.apiKey)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,203 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAPIKeyService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewCreateAPIKeyService("api-key", "secret").
+		SubAccount("strategy01").
+		Note("bot").
+		Permissions(PermissionSpotAccountRead, PermissionSpotDealWrite).
+		IPs("1.1.1.1", "2.2.2.2")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("subAccount"))
+	assert.Equal(t, "bot", q.Get("note"))
+	assert.Equal(t, "SPOT_ACCOUNT_READ,SPOT_DEAL_WRITE", q.Get("permissions"))
+	assert.Equal(t, "1.1.1.1,2.2.2.2", q.Get("ip"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCreateAPIKeyService_Validate_ErrorsWrapped(t *testing.T) {
+	ips := make([]string, maxAPIKeyIPs+1)
+	for i := range ips {
+		ips[i] = "10.0.0." + strconv.Itoa(i)
+	}
+
+	err := NewCreateAPIKeyService("", "").
+		Permissions(Permission("WITHDRAW")).
+		IPs(ips...).
+		Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CreateAPIKeyService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "subAccount is required")
+	assert.Contains(t, sdkErr.Message(), "note is required")
+	assert.Contains(t, sdkErr.Message(), "invalid permission: WITHDRAW")
+	assert.Contains(t, sdkErr.Message(), "at most 20 IPs are allowed")
+
+	err = NewCreateAPIKeyService("", "").SubAccount("a").Note("b").Validate()
+	assert.Contains(t, err.Error(), "at least one permission is required")
+}
+
+func TestCreateAPIKeyService_Do_Success(t *testing.T) {
+	body := `{
+		"subAccount": "strategy01",
+		"note": "bot",
+		"apiKey": "arg13sdfgs",
+		"secretKey": "hgfdgfghdfgfh",
+		"permissions": "SPOT_ACCOUNT_READ,SPOT_DEAL_WRITE",
+		"ip": "1.1.1.1",
+		"creatTime": 1597026383085
+	}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/sub-account/apiKey")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewCreateAPIKeyService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		SubAccount("strategy01").
+		Note("bot").
+		Permissions(PermissionSpotAccountRead, PermissionSpotDealWrite).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "arg13sdfgs", result.APIKey)
+	assert.Equal(t, "hgfdgfghdfgfh", result.SecretKey)
+	assert.Equal(t, Permissions{PermissionSpotAccountRead, PermissionSpotDealWrite}, result.Permissions)
+	assert.Equal(t, int64(1597026383085), result.CreateTime)
+}
+
+func TestAPIKeyService_buildQuery(t *testing.T) {
+	svc := NewAPIKeyService("api-key", "secret").SubAccount("strategy01")
+	svc.timestamp = func() int64 { return 1620000000000 }
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("subAccount"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestAPIKeyService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewAPIKeyService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, "APIKeyService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "subAccount is required")
+}
+
+func TestAPIKeyService_Do_Success(t *testing.T) {
+	body := `{"subAccount":[
+		{"note":"bot","apiKey":"arg13sdfgs","permissions":"SPOT_ACCOUNT_READ","ip":"","creatTime":1597026383085}
+	]}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/sub-account/apiKey")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewAPIKeyService("API_KEY", "SECRET_KEY").WithClient(fakeClient).SubAccount("strategy01").Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+	assert.Equal(t, "arg13sdfgs", result[0].APIKey)
+	assert.Equal(t, Permissions{PermissionSpotAccountRead}, result[0].Permissions)
+}
+
+func TestAPIKeyService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"code":140001,"msg":"sub account does not exist"}`)}, nil
+		},
+	}
+
+	result, err := NewAPIKeyService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+	assert.True(t, errors.Is(err, errs.ErrSubAccountNotExist))
+}
+
+func TestDeleteAPIKeyService_buildQuery(t *testing.T) {
+	svc := NewDeleteAPIKeyService("api-key", "secret").SubAccount("strategy01").APIKey("arg13sdfgs")
+	svc.timestamp = func() int64 { return 1620000000000 }
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("subAccount"))
+	assert.Equal(t, "arg13sdfgs", q.Get("apiKey"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestDeleteAPIKeyService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDeleteAPIKeyService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, "DeleteAPIKeyService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "subAccount is required")
+	assert.Contains(t, sdkErr.Message(), "apiKey is required")
+}
+
+func TestDeleteAPIKeyService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/sub-account/apiKey")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"subAccount":"strategy01"}`)}, nil
+		},
+	}
+
+	result, err := NewDeleteAPIKeyService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		SubAccount("strategy01").
+		APIKey("arg13sdfgs").
+		Do(context.Background())
+	assert.NoError(t, err)
+	assert.Equal(t, "strategy01", result)
+}
+
+func TestDeleteAPIKeyService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"code":140002,"msg":"sub account is forbidden"}`)}, nil
+		},
+	}
+
+	result, err := NewDeleteAPIKeyService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Empty(t, result)
+	assert.True(t, errors.Is(err, errs.ErrSubAccountForbidden))
+}
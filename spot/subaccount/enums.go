@@ -0,0 +1,125 @@
+package subaccount
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Permission represents a permission of a sub-account API key.
+type Permission string
+
+const (
+	PermissionSpotAccountRead      Permission = "SPOT_ACCOUNT_READ"
+	PermissionSpotAccountWrite     Permission = "SPOT_ACCOUNT_WRITE"
+	PermissionSpotDealRead         Permission = "SPOT_DEAL_READ"
+	PermissionSpotDealWrite        Permission = "SPOT_DEAL_WRITE"
+	PermissionContractAccountRead  Permission = "CONTRACT_ACCOUNT_READ"
+	PermissionContractAccountWrite Permission = "CONTRACT_ACCOUNT_WRITE"
+	PermissionContractDealRead     Permission = "CONTRACT_DEAL_READ"
+	PermissionContractDealWrite    Permission = "CONTRACT_DEAL_WRITE"
+	PermissionSpotTransferRead     Permission = "SPOT_TRANSFER_READ"
+	PermissionSpotTransferWrite    Permission = "SPOT_TRANSFER_WRITE"
+)
+
+// AccountType represents an account of a sub-account universal transfer.
+type AccountType string
+
+const (
+	AccountTypeSpot    AccountType = "SPOT"
+	AccountTypeFutures AccountType = "FUTURES"
+)
+
+func (p Permission) isValid() bool {
+	switch p {
+	case PermissionSpotAccountRead,
+		PermissionSpotAccountWrite,
+		PermissionSpotDealRead,
+		PermissionSpotDealWrite,
+		PermissionContractAccountRead,
+		PermissionContractAccountWrite,
+		PermissionContractDealRead,
+		PermissionContractDealWrite,
+		PermissionSpotTransferRead,
+		PermissionSpotTransferWrite:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t AccountType) isValid() bool {
+	switch t {
+	case AccountTypeSpot, AccountTypeFutures:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *Permission) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed := Permission(s)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid permission: %s", s)
+	}
+
+	*p = parsed
+	return nil
+}
+
+func (t *AccountType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed := AccountType(s)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid account type: %s", s)
+	}
+
+	*t = parsed
+	return nil
+}
+
+// Permissions is a set of API key permissions, encoded by the exchange
+// as a comma-separated string.
+type Permissions []Permission
+
+func (p Permissions) String() string {
+	parts := make([]string, len(p))
+	for i, perm := range p {
+		parts[i] = string(perm)
+	}
+	return strings.Join(parts, ",")
+}
+
+func (p *Permissions) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	if s == "" {
+		*p = nil
+		return nil
+	}
+
+	parts := strings.Split(s, ",")
+	parsed := make(Permissions, 0, len(parts))
+	for _, part := range parts {
+		perm := Permission(strings.TrimSpace(part))
+		if !perm.isValid() {
+			return fmt.Errorf("invalid permission: %s", perm)
+		}
+		parsed = append(parsed, perm)
+	}
+
+	*p = parsed
+	return nil
+}
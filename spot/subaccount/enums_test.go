@@ -0,0 +1,60 @@
+package subaccount
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPermission_UnmarshalJSON(t *testing.T) {
+	var p Permission
+
+	t.Run("valid permission", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"SPOT_DEAL_WRITE"`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, PermissionSpotDealWrite, p)
+	})
+
+	t.Run("invalid permission", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"WITHDRAW"`), &p)
+		assert.Error(t, err)
+	})
+}
+
+func TestAccountType_UnmarshalJSON(t *testing.T) {
+	var accountType AccountType
+
+	t.Run("valid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"SPOT"`), &accountType)
+		assert.NoError(t, err)
+		assert.Equal(t, AccountTypeSpot, accountType)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"MARGIN"`), &accountType)
+		assert.Error(t, err)
+	})
+}
+
+func TestPermissions_UnmarshalJSON(t *testing.T) {
+	var p Permissions
+
+	t.Run("comma separated", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"SPOT_ACCOUNT_READ,SPOT_DEAL_WRITE"`), &p)
+		assert.NoError(t, err)
+		assert.Equal(t, Permissions{PermissionSpotAccountRead, PermissionSpotDealWrite}, p)
+		assert.Equal(t, "SPOT_ACCOUNT_READ,SPOT_DEAL_WRITE", p.String())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`""`), &p)
+		assert.NoError(t, err)
+		assert.Empty(t, p)
+	})
+
+	t.Run("invalid permission", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"SPOT_ACCOUNT_READ,WITHDRAW"`), &p)
+		assert.Error(t, err)
+	})
+}
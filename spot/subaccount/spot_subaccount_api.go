@@ -0,0 +1,6 @@
+package subaccount
+
+const (
+	subsys         = "spot/subaccount"
+	defaultBaseURL = "https://api.mexc.com"
+)
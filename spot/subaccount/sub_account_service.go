@@ -0,0 +1,318 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// VirtualSubAccount represents a newly created virtual sub-account.
+type VirtualSubAccount struct {
+	SubAccount string `json:"subAccount"`
+	Note       string `json:"note"`
+}
+
+// SubAccount represents a sub-account of the user.
+type SubAccount struct {
+	SubAccount string `json:"subAccount"`
+	IsFreeze   bool   `json:"isFreeze"`
+	CreateTime int64  `json:"createTime"`
+	UID        string `json:"uid"`
+}
+
+type subAccountListResponse struct {
+	SubAccounts []SubAccount `json:"subAccounts"`
+}
+
+// CreateVirtualSubAccountService creates a virtual sub-account.
+type CreateVirtualSubAccountService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	subAccount string
+	note       string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewCreateVirtualSubAccountService creates a new CreateVirtualSubAccountService.
+func NewCreateVirtualSubAccountService(apiKey, secretKey string) *CreateVirtualSubAccountService {
+	return &CreateVirtualSubAccountService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CreateVirtualSubAccountService) WithClient(client transport.HTTPClient) *CreateVirtualSubAccountService {
+	s.client = client
+	return s
+}
+
+// SubAccount sets the name of the sub-account.
+func (s *CreateVirtualSubAccountService) SubAccount(name string) *CreateVirtualSubAccountService {
+	s.subAccount = name
+	return s
+}
+
+// Note sets the note of the sub-account.
+func (s *CreateVirtualSubAccountService) Note(note string) *CreateVirtualSubAccountService {
+	s.note = note
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CreateVirtualSubAccountService) RecvWindow(ms int64) *CreateVirtualSubAccountService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CreateVirtualSubAccountService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CreateVirtualSubAccountService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CreateVirtualSubAccountService) Do(ctx context.Context) (*VirtualSubAccount, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/sub-account/virtualSubAccount").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CreateVirtualSubAccountService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[VirtualSubAccount](resp.Body, subsys, op)
+}
+
+func (s *CreateVirtualSubAccountService) validate() error {
+	var errs []string
+
+	if s.subAccount == "" {
+		errs = append(errs, "subAccount is required")
+	}
+
+	if s.note == "" {
+		errs = append(errs, "note is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CreateVirtualSubAccountService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("subAccount", s.subAccount)
+	q.Add("note", s.note)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// SubAccountListService lists the sub-accounts of the user.
+type SubAccountListService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	subAccount *string
+	isFreeze   *bool
+	page       *int
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewSubAccountListService creates a new SubAccountListService.
+func NewSubAccountListService(apiKey, secretKey string) *SubAccountListService {
+	return &SubAccountListService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *SubAccountListService) WithClient(client transport.HTTPClient) *SubAccountListService {
+	s.client = client
+	return s
+}
+
+// SubAccount limits the result to a single sub-account.
+func (s *SubAccountListService) SubAccount(name string) *SubAccountListService {
+	s.subAccount = &name
+	return s
+}
+
+// IsFreeze limits the result to frozen or active sub-accounts.
+func (s *SubAccountListService) IsFreeze(frozen bool) *SubAccountListService {
+	s.isFreeze = &frozen
+	return s
+}
+
+// Page sets the page number, starting at 1.
+func (s *SubAccountListService) Page(n int) *SubAccountListService {
+	s.page = &n
+	return s
+}
+
+// Limit sets the number of records per page.
+func (s *SubAccountListService) Limit(n int) *SubAccountListService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *SubAccountListService) RecvWindow(ms int64) *SubAccountListService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *SubAccountListService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("SubAccountListService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *SubAccountListService) Do(ctx context.Context) ([]SubAccount, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/sub-account/list").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "SubAccountListService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	respObj, err := spotapi.DecodeResponse[subAccountListResponse](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return respObj.SubAccounts, nil
+}
+
+func (s *SubAccountListService) validate() error {
+	var errs []string
+
+	if s.page != nil && *s.page < 1 {
+		errs = append(errs, "page must be at least 1")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 200) {
+		errs = append(errs, "limit must be between 1 and 200")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *SubAccountListService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.subAccount != nil {
+		q.Add("subAccount", *s.subAccount)
+	}
+	if s.isFreeze != nil {
+		q.Add("isFreeze", strconv.FormatBool(*s.isFreeze))
+	}
+	if s.page != nil {
+		q.Add("page", strconv.Itoa(*s.page))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
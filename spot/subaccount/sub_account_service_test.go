@@ -0,0 +1,152 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateVirtualSubAccountService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewCreateVirtualSubAccountService("api-key", "secret").
+		SubAccount("strategy01").
+		Note("grid bot").
+		RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("subAccount"))
+	assert.Equal(t, "grid bot", q.Get("note"))
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCreateVirtualSubAccountService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewCreateVirtualSubAccountService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CreateVirtualSubAccountService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "subAccount is required")
+	assert.Contains(t, sdkErr.Message(), "note is required")
+}
+
+func TestCreateVirtualSubAccountService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/sub-account/virtualSubAccount")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"subAccount":"strategy01","note":"grid bot"}`)}, nil
+		},
+	}
+
+	result, err := NewCreateVirtualSubAccountService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		SubAccount("strategy01").
+		Note("grid bot").
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "strategy01", result.SubAccount)
+	assert.Equal(t, "grid bot", result.Note)
+}
+
+func TestCreateVirtualSubAccountService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"code":730601,"msg":"sub-account name must be a combination of 8-32 letters and numbers"}`)}, nil
+		},
+	}
+
+	result, err := NewCreateVirtualSubAccountService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+	assert.True(t, errors.Is(err, errs.ErrSubAccountNameInvalidFormat))
+}
+
+func TestSubAccountListService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewSubAccountListService("api-key", "secret").
+		SubAccount("strategy01").
+		IsFreeze(false).
+		Page(2).
+		Limit(50)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("subAccount"))
+	assert.Equal(t, "false", q.Get("isFreeze"))
+	assert.Equal(t, "2", q.Get("page"))
+	assert.Equal(t, "50", q.Get("limit"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestSubAccountListService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewSubAccountListService("", "").Page(0).Limit(201).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "SubAccountListService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "page must be at least 1")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 200")
+}
+
+func TestSubAccountListService_Do_Success(t *testing.T) {
+	body := `{"subAccounts":[
+		{"subAccount":"strategy01","isFreeze":false,"createTime":1544433328000,"uid":"49910594"},
+		{"subAccount":"strategy02","isFreeze":true,"createTime":1544433328000,"uid":"91794183"}
+	]}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/sub-account/list")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewSubAccountListService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "strategy01", result[0].SubAccount)
+	assert.Equal(t, "49910594", result[0].UID)
+	assert.True(t, result[1].IsFreeze)
+}
+
+func TestSubAccountListService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewSubAccountListService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
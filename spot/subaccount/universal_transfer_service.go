@@ -0,0 +1,426 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// UniversalTransferResult represents the response of a sub-account universal transfer.
+type UniversalTransferResult struct {
+	TranID string `json:"tranId"`
+}
+
+// UniversalTransferRecord represents a sub-account universal transfer record.
+type UniversalTransferRecord struct {
+	TranID          string          `json:"tranId"`
+	FromAccount     string          `json:"fromAccount"`
+	ToAccount       string          `json:"toAccount"`
+	ClientTranID    string          `json:"clientTranId"`
+	Asset           string          `json:"asset"`
+	Amount          decimal.Decimal `json:"amount"`
+	FromAccountType AccountType     `json:"fromAccountType"`
+	ToAccountType   AccountType     `json:"toAccountType"`
+	FromSymbol      string          `json:"fromSymbol"`
+	ToSymbol        string          `json:"toSymbol"`
+	Status          string          `json:"status"`
+	Timestamp       int64           `json:"timestamp"`
+}
+
+// UniversalTransferHistory represents a page of sub-account universal transfer records.
+type UniversalTransferHistory struct {
+	TotalCount int                       `json:"totalCount"`
+	Result     []UniversalTransferRecord `json:"result"`
+}
+
+// UniversalTransferService transfers an asset between the master account
+// and its sub-accounts. An empty fromAccount or toAccount denotes the master account.
+type UniversalTransferService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	fromAccount     *string
+	toAccount       *string
+	fromAccountType AccountType
+	toAccountType   AccountType
+	asset           string
+	amount          decimal.Decimal
+	recvWindow      *int64
+	timestamp       func() int64
+}
+
+// NewUniversalTransferService creates a new UniversalTransferService.
+func NewUniversalTransferService(apiKey, secretKey string) *UniversalTransferService {
+	return &UniversalTransferService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *UniversalTransferService) WithClient(client transport.HTTPClient) *UniversalTransferService {
+	s.client = client
+	return s
+}
+
+// FromAccount sets the source sub-account.
+func (s *UniversalTransferService) FromAccount(name string) *UniversalTransferService {
+	s.fromAccount = &name
+	return s
+}
+
+// ToAccount sets the destination sub-account.
+func (s *UniversalTransferService) ToAccount(name string) *UniversalTransferService {
+	s.toAccount = &name
+	return s
+}
+
+// FromAccountType sets the source account type.
+func (s *UniversalTransferService) FromAccountType(t AccountType) *UniversalTransferService {
+	s.fromAccountType = t
+	return s
+}
+
+// ToAccountType sets the destination account type.
+func (s *UniversalTransferService) ToAccountType(t AccountType) *UniversalTransferService {
+	s.toAccountType = t
+	return s
+}
+
+// Asset sets the asset to transfer.
+func (s *UniversalTransferService) Asset(asset string) *UniversalTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount sets the amount to transfer.
+func (s *UniversalTransferService) Amount(amount decimal.Decimal) *UniversalTransferService {
+	s.amount = amount
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *UniversalTransferService) RecvWindow(ms int64) *UniversalTransferService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *UniversalTransferService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("UniversalTransferService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *UniversalTransferService) Do(ctx context.Context) (*UniversalTransferResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/sub-account/universalTransfer").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "UniversalTransferService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[UniversalTransferResult](resp.Body, subsys, op)
+}
+
+func (s *UniversalTransferService) validate() error {
+	var errs []string
+
+	if !s.fromAccountType.isValid() {
+		errs = append(errs, "fromAccountType is invalid")
+	}
+
+	if !s.toAccountType.isValid() {
+		errs = append(errs, "toAccountType is invalid")
+	}
+
+	if s.fromAccount == nil && s.toAccount == nil {
+		errs = append(errs, "at least one of fromAccount or toAccount is required")
+	}
+
+	if s.asset == "" {
+		errs = append(errs, "asset is required")
+	}
+
+	if !s.amount.IsPositive() {
+		errs = append(errs, "amount must be positive")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *UniversalTransferService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.fromAccount != nil {
+		q.Add("fromAccount", *s.fromAccount)
+	}
+	if s.toAccount != nil {
+		q.Add("toAccount", *s.toAccount)
+	}
+
+	q.Add("fromAccountType", string(s.fromAccountType))
+	q.Add("toAccountType", string(s.toAccountType))
+	q.Add("asset", s.asset)
+	q.Add("amount", s.amount.String())
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// UniversalTransferHistoryService gets the sub-account universal transfer history.
+type UniversalTransferHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	fromAccount     *string
+	toAccount       *string
+	fromAccountType AccountType
+	toAccountType   AccountType
+	startTime       *int64
+	endTime         *int64
+	page            *int
+	limit           *int
+	recvWindow      *int64
+	timestamp       func() int64
+}
+
+// NewUniversalTransferHistoryService creates a new UniversalTransferHistoryService.
+func NewUniversalTransferHistoryService(apiKey, secretKey string) *UniversalTransferHistoryService {
+	return &UniversalTransferHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *UniversalTransferHistoryService) WithClient(client transport.HTTPClient) *UniversalTransferHistoryService {
+	s.client = client
+	return s
+}
+
+// FromAccount limits the result to transfers from a sub-account.
+func (s *UniversalTransferHistoryService) FromAccount(name string) *UniversalTransferHistoryService {
+	s.fromAccount = &name
+	return s
+}
+
+// ToAccount limits the result to transfers to a sub-account.
+func (s *UniversalTransferHistoryService) ToAccount(name string) *UniversalTransferHistoryService {
+	s.toAccount = &name
+	return s
+}
+
+// FromAccountType sets the source account type.
+func (s *UniversalTransferHistoryService) FromAccountType(t AccountType) *UniversalTransferHistoryService {
+	s.fromAccountType = t
+	return s
+}
+
+// ToAccountType sets the destination account type.
+func (s *UniversalTransferHistoryService) ToAccountType(t AccountType) *UniversalTransferHistoryService {
+	s.toAccountType = t
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *UniversalTransferHistoryService) StartTime(ms int64) *UniversalTransferHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *UniversalTransferHistoryService) EndTime(ms int64) *UniversalTransferHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Page sets the page number, starting at 1.
+func (s *UniversalTransferHistoryService) Page(n int) *UniversalTransferHistoryService {
+	s.page = &n
+	return s
+}
+
+// Limit sets the number of records per page.
+func (s *UniversalTransferHistoryService) Limit(n int) *UniversalTransferHistoryService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *UniversalTransferHistoryService) RecvWindow(ms int64) *UniversalTransferHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *UniversalTransferHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("UniversalTransferHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *UniversalTransferHistoryService) Do(ctx context.Context) (*UniversalTransferHistory, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/sub-account/universalTransfer").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "UniversalTransferHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[UniversalTransferHistory](resp.Body, subsys, op)
+}
+
+func (s *UniversalTransferHistoryService) validate() error {
+	var errs []string
+
+	if !s.fromAccountType.isValid() {
+		errs = append(errs, "fromAccountType is invalid")
+	}
+
+	if !s.toAccountType.isValid() {
+		errs = append(errs, "toAccountType is invalid")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.page != nil && *s.page < 1 {
+		errs = append(errs, "page must be at least 1")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 50) {
+		errs = append(errs, "limit must be between 1 and 50")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *UniversalTransferHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.fromAccount != nil {
+		q.Add("fromAccount", *s.fromAccount)
+	}
+	if s.toAccount != nil {
+		q.Add("toAccount", *s.toAccount)
+	}
+
+	q.Add("fromAccountType", string(s.fromAccountType))
+	q.Add("toAccountType", string(s.toAccountType))
+
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.page != nil {
+		q.Add("page", strconv.Itoa(*s.page))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,170 @@
+package subaccount
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUniversalTransferService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewUniversalTransferService("api-key", "secret").
+		ToAccount("strategy01").
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		Asset("USDT").
+		Amount(decimal.RequireFromString("100.25"))
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.False(t, q.Has("fromAccount"))
+	assert.Equal(t, "strategy01", q.Get("toAccount"))
+	assert.Equal(t, "SPOT", q.Get("fromAccountType"))
+	assert.Equal(t, "FUTURES", q.Get("toAccountType"))
+	assert.Equal(t, "USDT", q.Get("asset"))
+	assert.Equal(t, "100.25", q.Get("amount"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestUniversalTransferService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewUniversalTransferService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "UniversalTransferService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "fromAccountType is invalid")
+	assert.Contains(t, sdkErr.Message(), "toAccountType is invalid")
+	assert.Contains(t, sdkErr.Message(), "at least one of fromAccount or toAccount is required")
+	assert.Contains(t, sdkErr.Message(), "asset is required")
+	assert.Contains(t, sdkErr.Message(), "amount must be positive")
+}
+
+func TestUniversalTransferService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/sub-account/universalTransfer")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"tranId":"664424263"}`)}, nil
+		},
+	}
+
+	result, err := NewUniversalTransferService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		FromAccount("strategy01").
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeSpot).
+		Asset("USDT").
+		Amount(decimal.NewFromInt(1)).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "664424263", result.TranID)
+}
+
+func TestUniversalTransferHistoryService_buildQuery(t *testing.T) {
+	svc := NewUniversalTransferHistoryService("api-key", "secret").
+		FromAccount("strategy01").
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		StartTime(1000).
+		EndTime(2000).
+		Page(1).
+		Limit(10)
+	svc.timestamp = func() int64 { return 1620000000000 }
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "strategy01", q.Get("fromAccount"))
+	assert.False(t, q.Has("toAccount"))
+	assert.Equal(t, "SPOT", q.Get("fromAccountType"))
+	assert.Equal(t, "FUTURES", q.Get("toAccountType"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "1", q.Get("page"))
+	assert.Equal(t, "10", q.Get("limit"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestUniversalTransferHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewUniversalTransferHistoryService("", "").Limit(51).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, "UniversalTransferHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "fromAccountType is invalid")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 50")
+}
+
+func TestUniversalTransferHistoryService_Do_Success(t *testing.T) {
+	body := `{
+		"totalCount": 1,
+		"result": [{
+			"tranId": "664424263",
+			"fromAccount": "strategy01",
+			"toAccount": "",
+			"clientTranId": "",
+			"asset": "USDT",
+			"amount": "1",
+			"fromAccountType": "SPOT",
+			"toAccountType": "FUTURES",
+			"fromSymbol": "",
+			"toSymbol": "",
+			"status": "SUCCESS",
+			"timestamp": 1544433325000
+		}]
+	}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/sub-account/universalTransfer")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewUniversalTransferHistoryService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalCount)
+	require.Len(t, result.Result, 1)
+
+	r := result.Result[0]
+	assert.Equal(t, "strategy01", r.FromAccount)
+	assert.Equal(t, AccountTypeFutures, r.ToAccountType)
+	testutil.AssertDecimalEqual(t, r.Amount, "1", "amount mismatch")
+}
+
+func TestUniversalTransferHistoryService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewUniversalTransferHistoryService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
@@ -0,0 +1,144 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// CoinConfig represents the deposit and withdrawal configuration of a coin.
+type CoinConfig struct {
+	Coin        string          `json:"coin"`
+	Name        string          `json:"name"`
+	NetworkList []NetworkConfig `json:"networkList"`
+}
+
+// NetworkConfig represents the configuration of a coin on a single network.
+type NetworkConfig struct {
+	Coin                    string          `json:"coin"`
+	Network                 string          `json:"network"`
+	Name                    string          `json:"name"`
+	Contract                string          `json:"contract"`
+	DepositEnable           bool            `json:"depositEnable"`
+	DepositDesc             string          `json:"depositDesc"`
+	DepositTips             string          `json:"depositTips"`
+	WithdrawEnable          bool            `json:"withdrawEnable"`
+	WithdrawFee             decimal.Decimal `json:"withdrawFee"`
+	WithdrawMin             decimal.Decimal `json:"withdrawMin"`
+	WithdrawMax             decimal.Decimal `json:"withdrawMax"`
+	WithdrawIntegerMultiple decimal.Decimal `json:"withdrawIntegerMultiple"`
+	WithdrawTips            string          `json:"withdrawTips"`
+	MinConfirm              int             `json:"minConfirm"`
+	SameAddress             bool            `json:"sameAddress"`
+}
+
+// CoinConfigService gets the deposit and withdrawal configuration of all coins.
+type CoinConfigService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewCoinConfigService creates a new CoinConfigService.
+func NewCoinConfigService(apiKey, secretKey string) *CoinConfigService {
+	return &CoinConfigService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CoinConfigService) WithClient(client transport.HTTPClient) *CoinConfigService {
+	s.client = client
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CoinConfigService) RecvWindow(ms int64) *CoinConfigService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CoinConfigService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CoinConfigService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CoinConfigService) Do(ctx context.Context) ([]CoinConfig, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/config/getall").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CoinConfigService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	configs, err := spotapi.DecodeResponse[[]CoinConfig](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return *configs, nil
+}
+
+func (s *CoinConfigService) validate() error {
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			return errors.New("recvWindow must be between 1 and 60000")
+		}
+	}
+	return nil
+}
+
+func (s *CoinConfigService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const coinConfigJSON = `[{
+	"coin": "USDT",
+	"Name": "TetherUS",
+	"networkList": [
+		{
+			"coin": "USDT",
+			"depositDesc": null,
+			"depositEnable": true,
+			"minConfirm": 12,
+			"Name": "Tether USD",
+			"network": "ERC20",
+			"withdrawEnable": true,
+			"withdrawFee": "2.000000000000000000",
+			"withdrawIntegerMultiple": null,
+			"withdrawMax": "1000000.000000000000000000",
+			"withdrawMin": "10.000000000000000000",
+			"sameAddress": false,
+			"contract": "0xdac17f958d2ee523a2206206994597c13d831ec7",
+			"withdrawTips": null,
+			"depositTips": null,
+			"netWork": "ERC20"
+		},
+		{
+			"coin": "USDT",
+			"depositEnable": true,
+			"minConfirm": 1,
+			"network": "TRC20",
+			"withdrawEnable": false,
+			"withdrawFee": "1",
+			"withdrawMax": "500000",
+			"withdrawMin": "5"
+		}
+	]
+}]`
+
+func TestCoinConfigService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewCoinConfigService("api-key", "secret").RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCoinConfigService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewCoinConfigService("", "").RecvWindow(0).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CoinConfigService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "recvWindow must be between 1 and 60000")
+}
+
+func TestCoinConfigService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/config/getall")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(coinConfigJSON)}, nil
+		},
+	}
+
+	result, err := NewCoinConfigService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	coin := result[0]
+	assert.Equal(t, "USDT", coin.Coin)
+	assert.Equal(t, "TetherUS", coin.Name)
+	require.Len(t, coin.NetworkList, 2)
+
+	n := coin.NetworkList[0]
+	assert.Equal(t, "ERC20", n.Network)
+	assert.True(t, n.DepositEnable)
+	assert.True(t, n.WithdrawEnable)
+	assert.Equal(t, 12, n.MinConfirm)
+	assert.Equal(t, "0xdac17f958d2ee523a2206206994597c13d831ec7", n.Contract)
+	testutil.AssertDecimalEqual(t, n.WithdrawFee, "2", "withdraw fee mismatch")
+	testutil.AssertDecimalEqual(t, n.WithdrawMin, "10", "withdraw min mismatch")
+	testutil.AssertDecimalEqual(t, n.WithdrawMax, "1000000", "withdraw max mismatch")
+}
+
+func TestCoinConfigService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewCoinConfigService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
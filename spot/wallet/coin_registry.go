@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+var (
+	// ErrCoinNotFound is returned when a coin is not present in the registry.
+	ErrCoinNotFound = errors.New("coin not found")
+	// ErrNetworkNotFound is returned when a coin is not available on a network.
+	ErrNetworkNotFound = errors.New("network not found")
+	// ErrWithdrawDisabled is returned when withdrawals are disabled for a coin on a network.
+	ErrWithdrawDisabled = errors.New("withdrawal disabled")
+	// ErrWithdrawAmountTooSmall is returned when an amount is below the withdrawal minimum.
+	ErrWithdrawAmountTooSmall = errors.New("withdrawal amount below minimum")
+	// ErrWithdrawAmountTooLarge is returned when an amount is above the withdrawal maximum.
+	ErrWithdrawAmountTooLarge = errors.New("withdrawal amount above maximum")
+)
+
+// CoinRegistry is an in-memory cache of coin network configurations.
+// It is populated by Refresh or Load and answers lookups without
+// querying the exchange. It is safe for concurrent use.
+type CoinRegistry struct {
+	svc *CoinConfigService
+
+	mu        sync.RWMutex
+	coins     map[string][]NetworkConfig
+	updatedAt time.Time
+}
+
+// NewCoinRegistry creates an empty CoinRegistry that refreshes through svc.
+func NewCoinRegistry(svc *CoinConfigService) *CoinRegistry {
+	return &CoinRegistry{
+		svc:   svc,
+		coins: make(map[string][]NetworkConfig),
+	}
+}
+
+// Refresh queries the exchange and replaces the cached configuration.
+// On error the previous configuration is kept.
+func (r *CoinRegistry) Refresh(ctx context.Context) error {
+	configs, err := r.svc.Do(ctx)
+	if err != nil {
+		return err
+	}
+	r.Load(configs)
+	return nil
+}
+
+// Load replaces the cached configuration with configs.
+func (r *CoinRegistry) Load(configs []CoinConfig) {
+	coins := make(map[string][]NetworkConfig, len(configs))
+	for _, c := range configs {
+		coins[c.Coin] = append([]NetworkConfig(nil), c.NetworkList...)
+	}
+
+	r.mu.Lock()
+	r.coins = coins
+	r.updatedAt = time.Now()
+	r.mu.Unlock()
+}
+
+// UpdatedAt returns the time of the last successful Load or Refresh.
+func (r *CoinRegistry) UpdatedAt() time.Time {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.updatedAt
+}
+
+// Networks returns the network configurations of a coin in the order the
+// exchange listed them.
+func (r *CoinRegistry) Networks(coin string) []NetworkConfig {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	return append([]NetworkConfig{}, r.coins[coin]...)
+}
+
+// Network returns the configuration of a coin on a network.
+func (r *CoinRegistry) Network(coin, network string) (NetworkConfig, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	networks, ok := r.coins[coin]
+	if !ok {
+		return NetworkConfig{}, fmt.Errorf("%w: %s", ErrCoinNotFound, coin)
+	}
+	for _, n := range networks {
+		if n.Network == network {
+			return n, nil
+		}
+	}
+	return NetworkConfig{}, fmt.Errorf("%w: %s on %s", ErrNetworkNotFound, coin, network)
+}
+
+// CheckWithdraw reports whether amount of coin can be withdrawn on network
+// and returns the withdrawal fee.
+func (r *CoinRegistry) CheckWithdraw(coin, network string, amount decimal.Decimal) (decimal.Decimal, error) {
+	n, err := r.Network(coin, network)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if !n.WithdrawEnable {
+		return decimal.Zero, fmt.Errorf("%w: %s on %s", ErrWithdrawDisabled, coin, network)
+	}
+	if amount.LessThan(n.WithdrawMin) {
+		return decimal.Zero, fmt.Errorf("%w: %s < %s", ErrWithdrawAmountTooSmall, amount, n.WithdrawMin)
+	}
+	if n.WithdrawMax.IsPositive() && amount.GreaterThan(n.WithdrawMax) {
+		return decimal.Zero, fmt.Errorf("%w: %s > %s", ErrWithdrawAmountTooLarge, amount, n.WithdrawMax)
+	}
+	return n.WithdrawFee, nil
+}
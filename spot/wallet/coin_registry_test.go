@@ -0,0 +1,120 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestRegistry(t *testing.T, calls *int) *CoinRegistry {
+	t.Helper()
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			*calls++
+			return &transport.Response{StatusCode: 200, Body: []byte(coinConfigJSON)}, nil
+		},
+	}
+	svc := NewCoinConfigService("API_KEY", "SECRET_KEY").WithClient(fakeClient)
+	return NewCoinRegistry(svc)
+}
+
+func TestCoinRegistry_Refresh(t *testing.T) {
+	var calls int
+	reg := newTestRegistry(t, &calls)
+
+	assert.True(t, reg.UpdatedAt().IsZero())
+	require.NoError(t, reg.Refresh(context.Background()))
+	assert.Equal(t, 1, calls)
+	assert.False(t, reg.UpdatedAt().IsZero())
+
+	networks := reg.Networks("USDT")
+	require.Len(t, networks, 2)
+	assert.Equal(t, "ERC20", networks[0].Network)
+	assert.Equal(t, "TRC20", networks[1].Network)
+	assert.Empty(t, reg.Networks("BTC"))
+
+	_, err := reg.Network("USDT", "ERC20")
+	assert.NoError(t, err)
+	_, err = reg.CheckWithdraw("USDT", "ERC20", decimal.NewFromInt(100))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls, "lookups must not query the exchange")
+}
+
+func TestCoinRegistry_Refresh_KeepsPreviousOnError(t *testing.T) {
+	fail := false
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			if fail {
+				return nil, errors.New("network is down")
+			}
+			return &transport.Response{StatusCode: 200, Body: []byte(coinConfigJSON)}, nil
+		},
+	}
+	reg := NewCoinRegistry(NewCoinConfigService("", "").WithClient(fakeClient))
+
+	require.NoError(t, reg.Refresh(context.Background()))
+	fail = true
+	assert.Error(t, reg.Refresh(context.Background()))
+	assert.Len(t, reg.Networks("USDT"), 2)
+}
+
+func TestCoinRegistry_Network(t *testing.T) {
+	var calls int
+	reg := newTestRegistry(t, &calls)
+	require.NoError(t, reg.Refresh(context.Background()))
+
+	t.Run("found", func(t *testing.T) {
+		n, err := reg.Network("USDT", "TRC20")
+		assert.NoError(t, err)
+		assert.Equal(t, 1, n.MinConfirm)
+	})
+
+	t.Run("unknown coin", func(t *testing.T) {
+		_, err := reg.Network("DOGE", "TRC20")
+		assert.ErrorIs(t, err, ErrCoinNotFound)
+	})
+
+	t.Run("unknown network", func(t *testing.T) {
+		_, err := reg.Network("USDT", "BEP20")
+		assert.ErrorIs(t, err, ErrNetworkNotFound)
+	})
+}
+
+func TestCoinRegistry_CheckWithdraw(t *testing.T) {
+	var calls int
+	reg := newTestRegistry(t, &calls)
+	require.NoError(t, reg.Refresh(context.Background()))
+
+	cases := []struct {
+		name    string
+		network string
+		amount  string
+		wantErr error
+		wantFee string
+	}{
+		{name: "allowed", network: "ERC20", amount: "100", wantFee: "2"},
+		{name: "at minimum", network: "ERC20", amount: "10", wantFee: "2"},
+		{name: "below minimum", network: "ERC20", amount: "9.99", wantErr: ErrWithdrawAmountTooSmall},
+		{name: "above maximum", network: "ERC20", amount: "1000000.01", wantErr: ErrWithdrawAmountTooLarge},
+		{name: "disabled", network: "TRC20", amount: "100", wantErr: ErrWithdrawDisabled},
+		{name: "unknown network", network: "SOL", amount: "100", wantErr: ErrNetworkNotFound},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee, err := reg.CheckWithdraw("USDT", tc.network, decimal.RequireFromString(tc.amount))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			testutil.AssertDecimalEqual(t, fee, tc.wantFee, "fee mismatch")
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+)
+
+// DepositAddress represents a deposit address of a coin on a network.
+type DepositAddress struct {
+	Coin    string `json:"coin"`
+	Network string `json:"network"`
+	Address string `json:"address"`
+	Memo    string `json:"memo"`
+}
+
+// GenerateDepositAddressService generates a new deposit address.
+type GenerateDepositAddressService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	coin       string
+	network    string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewGenerateDepositAddressService creates a new GenerateDepositAddressService.
+func NewGenerateDepositAddressService(apiKey, secretKey string) *GenerateDepositAddressService {
+	return &GenerateDepositAddressService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *GenerateDepositAddressService) WithClient(client transport.HTTPClient) *GenerateDepositAddressService {
+	s.client = client
+	return s
+}
+
+// Coin sets the coin of the address.
+func (s *GenerateDepositAddressService) Coin(coin string) *GenerateDepositAddressService {
+	s.coin = coin
+	return s
+}
+
+// Network sets the network of the address.
+func (s *GenerateDepositAddressService) Network(network string) *GenerateDepositAddressService {
+	s.network = network
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *GenerateDepositAddressService) RecvWindow(ms int64) *GenerateDepositAddressService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *GenerateDepositAddressService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("GenerateDepositAddressService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *GenerateDepositAddressService) Do(ctx context.Context) (*DepositAddress, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/deposit/address").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "GenerateDepositAddressService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[DepositAddress](resp.Body, subsys, op)
+}
+
+func (s *GenerateDepositAddressService) validate() error {
+	var errs []string
+
+	if s.coin == "" {
+		errs = append(errs, "coin is required")
+	}
+
+	if s.network == "" {
+		errs = append(errs, "network is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *GenerateDepositAddressService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("coin", s.coin)
+	q.Add("network", s.network)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// DepositAddressService lists the deposit addresses of a coin.
+type DepositAddressService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	coin       string
+	network    *string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDepositAddressService creates a new DepositAddressService.
+func NewDepositAddressService(apiKey, secretKey string) *DepositAddressService {
+	return &DepositAddressService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DepositAddressService) WithClient(client transport.HTTPClient) *DepositAddressService {
+	s.client = client
+	return s
+}
+
+// Coin sets the coin of the addresses.
+func (s *DepositAddressService) Coin(coin string) *DepositAddressService {
+	s.coin = coin
+	return s
+}
+
+// Network limits the result to a single network.
+func (s *DepositAddressService) Network(network string) *DepositAddressService {
+	s.network = &network
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DepositAddressService) RecvWindow(ms int64) *DepositAddressService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DepositAddressService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DepositAddressService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DepositAddressService) Do(ctx context.Context) ([]DepositAddress, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/deposit/address").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DepositAddressService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	addresses, err := spotapi.DecodeResponse[[]DepositAddress](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return *addresses, nil
+}
+
+func (s *DepositAddressService) validate() error {
+	var errs []string
+
+	if s.coin == "" {
+		errs = append(errs, "coin is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DepositAddressService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("coin", s.coin)
+
+	if s.network != nil {
+		q.Add("network", *s.network)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,136 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateDepositAddressService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewGenerateDepositAddressService("api-key", "secret").
+		Coin("USDT").
+		Network("TRC20").
+		RecvWindow(5000)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "USDT", q.Get("coin"))
+	assert.Equal(t, "TRC20", q.Get("network"))
+	assert.Equal(t, "5000", q.Get("recvWindow"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestGenerateDepositAddressService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewGenerateDepositAddressService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "GenerateDepositAddressService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "coin is required")
+	assert.Contains(t, sdkErr.Message(), "network is required")
+}
+
+func TestGenerateDepositAddressService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/deposit/address")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			body := `{"coin":"USDT","network":"TRC20","address":"TXyz","memo":null}`
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewGenerateDepositAddressService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Coin("USDT").
+		Network("TRC20").
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "USDT", result.Coin)
+	assert.Equal(t, "TRC20", result.Network)
+	assert.Equal(t, "TXyz", result.Address)
+	assert.Empty(t, result.Memo)
+}
+
+func TestDepositAddressService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewDepositAddressService("api-key", "secret").Coin("USDT")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+	assert.Equal(t, "USDT", q.Get("coin"))
+	assert.False(t, q.Has("network"))
+
+	q = svc.Network("ERC20").buildQuery()
+	assert.Equal(t, "ERC20", q.Get("network"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestDepositAddressService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDepositAddressService("", "").RecvWindow(0).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "DepositAddressService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "coin is required")
+	assert.Contains(t, sdkErr.Message(), "recvWindow must be between 1 and 60000")
+}
+
+func TestDepositAddressService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/deposit/address")
+
+			body := `[
+				{"coin":"USDT","network":"TRC20","address":"TXyz","memo":null},
+				{"coin":"XRP","network":"XRP","address":"rAbc","memo":"12345"}
+			]`
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewDepositAddressService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Coin("USDT").
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "TXyz", result[0].Address)
+	assert.Equal(t, "12345", result[1].Memo)
+}
+
+func TestDepositAddressService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewDepositAddressService("", "").WithClient(fakeClient).Coin("USDT").Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
@@ -0,0 +1,201 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Deposit represents a deposit record.
+type Deposit struct {
+	Amount        decimal.Decimal `json:"amount"`
+	Coin          string          `json:"coin"`
+	Network       string          `json:"network"`
+	Status        DepositStatus   `json:"status"`
+	Address       string          `json:"address"`
+	TxID          string          `json:"txId"`
+	InsertTime    int64           `json:"insertTime"`
+	UnlockConfirm string          `json:"unlockConfirm"`
+	ConfirmTimes  string          `json:"confirmTimes"`
+	Memo          string          `json:"memo"`
+}
+
+// DepositHistoryService gets the deposit history.
+type DepositHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	coin       *string
+	status     *DepositStatus
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDepositHistoryService creates a new DepositHistoryService.
+func NewDepositHistoryService(apiKey, secretKey string) *DepositHistoryService {
+	return &DepositHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DepositHistoryService) WithClient(client transport.HTTPClient) *DepositHistoryService {
+	s.client = client
+	return s
+}
+
+// Coin limits the result to a single coin.
+func (s *DepositHistoryService) Coin(coin string) *DepositHistoryService {
+	s.coin = &coin
+	return s
+}
+
+// Status limits the result to deposits with the given status.
+func (s *DepositHistoryService) Status(status DepositStatus) *DepositHistoryService {
+	s.status = &status
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *DepositHistoryService) StartTime(ms int64) *DepositHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *DepositHistoryService) EndTime(ms int64) *DepositHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of records to return.
+func (s *DepositHistoryService) Limit(n int) *DepositHistoryService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DepositHistoryService) RecvWindow(ms int64) *DepositHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DepositHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DepositHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DepositHistoryService) Do(ctx context.Context) ([]Deposit, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/deposit/hisrec").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DepositHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	deposits, err := spotapi.DecodeResponse[[]Deposit](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return *deposits, nil
+}
+
+func (s *DepositHistoryService) validate() error {
+	var errs []string
+
+	if s.status != nil && !s.status.isValid() {
+		errs = append(errs, "status is invalid")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DepositHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.coin != nil {
+		q.Add("coin", *s.coin)
+	}
+	if s.status != nil {
+		q.Add("status", strconv.Itoa(int(*s.status)))
+	}
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,105 @@
+package wallet
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const depositHistoryJSON = `[{
+	"amount": "50000",
+	"coin": "EOS",
+	"network": "EOS",
+	"status": 5,
+	"address": "0x20b7cf77db93d6ef1ab979c49d11cd6d0ae9b2a9",
+	"txId": "01391d1c3d3ab1c8ebc8ee8d5ec1d1b2e0b6b3c4",
+	"insertTime": 1659513342000,
+	"unlockConfirm": "10",
+	"confirmTimes": "241",
+	"memo": "xxyy1122"
+}]`
+
+func TestDepositHistoryService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewDepositHistoryService("api-key", "secret").
+		Coin("EOS").
+		Status(DepositStatusSuccess).
+		StartTime(1000).
+		EndTime(2000).
+		Limit(50)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "EOS", q.Get("coin"))
+	assert.Equal(t, "5", q.Get("status"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "50", q.Get("limit"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestDepositHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDepositHistoryService("", "").
+		Status(DepositStatus(42)).
+		StartTime(2000).
+		EndTime(1000).
+		Limit(1001).
+		Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "DepositHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "status is invalid")
+	assert.Contains(t, sdkErr.Message(), "startTime must not be after endTime")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 1000")
+}
+
+func TestDepositHistoryService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/deposit/hisrec")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(depositHistoryJSON)}, nil
+		},
+	}
+
+	result, err := NewDepositHistoryService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	d := result[0]
+	testutil.AssertDecimalEqual(t, d.Amount, "50000", "amount mismatch")
+	assert.Equal(t, "EOS", d.Coin)
+	assert.Equal(t, DepositStatusSuccess, d.Status)
+	assert.Equal(t, int64(1659513342000), d.InsertTime)
+	assert.Equal(t, "241", d.ConfirmTimes)
+	assert.Equal(t, "xxyy1122", d.Memo)
+}
+
+func TestDepositHistoryService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"code":700002,"msg":"Signature for this request is not valid."}`)}, nil
+		},
+	}
+
+	result, err := NewDepositHistoryService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+}
@@ -0,0 +1,446 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+const maxDustAssets = 15
+
+// DustAsset represents a small balance that can be converted to MX.
+type DustAsset struct {
+	Asset       string          `json:"asset"`
+	Balance     decimal.Decimal `json:"balance"`
+	ConvertMx   decimal.Decimal `json:"convertMx"`
+	ConvertUsdt decimal.Decimal `json:"convertUsdt"`
+}
+
+// DustConvertResult represents the result of a dust conversion.
+type DustConvertResult struct {
+	SuccessList  []string        `json:"successList"`
+	FailedList   []string        `json:"failedList"`
+	TotalConvert decimal.Decimal `json:"totalConvert"`
+	ConvertFee   decimal.Decimal `json:"convertFee"`
+}
+
+// DustConvertDetail represents the conversion of a single asset.
+type DustConvertDetail struct {
+	ID      string          `json:"id"`
+	Asset   string          `json:"asset"`
+	Amount  decimal.Decimal `json:"amount"`
+	Convert decimal.Decimal `json:"convert"`
+	Fee     decimal.Decimal `json:"fee"`
+	Time    int64           `json:"time"`
+}
+
+// DustConversion represents a single dust conversion.
+type DustConversion struct {
+	TotalConvert   decimal.Decimal     `json:"totalConvert"`
+	TotalFee       decimal.Decimal     `json:"totalFee"`
+	ConvertTime    int64               `json:"convertTime"`
+	ConvertDetails []DustConvertDetail `json:"convertDetails"`
+}
+
+// DustHistory represents a page of dust conversions.
+type DustHistory struct {
+	Data         []DustConversion `json:"data"`
+	TotalRecords int              `json:"totalRecords"`
+	Page         int              `json:"page"`
+	TotalPageNum int              `json:"totalPageNum"`
+}
+
+// DustListService lists the assets that can be converted to MX.
+type DustListService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDustListService creates a new DustListService.
+func NewDustListService(apiKey, secretKey string) *DustListService {
+	return &DustListService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DustListService) WithClient(client transport.HTTPClient) *DustListService {
+	s.client = client
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DustListService) RecvWindow(ms int64) *DustListService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DustListService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DustListService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DustListService) Do(ctx context.Context) ([]DustAsset, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/convert/list").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DustListService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	assets, err := spotapi.DecodeResponse[[]DustAsset](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return *assets, nil
+}
+
+func (s *DustListService) validate() error {
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			return errors.New("recvWindow must be between 1 and 60000")
+		}
+	}
+	return nil
+}
+
+func (s *DustListService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// DustConvertService converts small balances to MX.
+type DustConvertService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	assets     []string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDustConvertService creates a new DustConvertService.
+func NewDustConvertService(apiKey, secretKey string) *DustConvertService {
+	return &DustConvertService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DustConvertService) WithClient(client transport.HTTPClient) *DustConvertService {
+	s.client = client
+	return s
+}
+
+// Assets sets the assets to convert (max 15).
+func (s *DustConvertService) Assets(assets ...string) *DustConvertService {
+	s.assets = assets
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DustConvertService) RecvWindow(ms int64) *DustConvertService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DustConvertService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DustConvertService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DustConvertService) Do(ctx context.Context) (*DustConvertResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/convert").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DustConvertService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[DustConvertResult](resp.Body, subsys, op)
+}
+
+func (s *DustConvertService) validate() error {
+	var errs []string
+
+	if len(s.assets) == 0 {
+		errs = append(errs, "at least one asset is required")
+	}
+
+	if len(s.assets) > maxDustAssets {
+		errs = append(errs, fmt.Sprintf("at most %d assets are allowed", maxDustAssets))
+	}
+
+	for _, a := range s.assets {
+		if a == "" {
+			errs = append(errs, "asset must not be empty")
+			break
+		}
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DustConvertService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("asset", strings.Join(s.assets, ","))
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// DustHistoryService gets the dust conversion history.
+type DustHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	startTime  *int64
+	endTime    *int64
+	page       *int
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewDustHistoryService creates a new DustHistoryService.
+func NewDustHistoryService(apiKey, secretKey string) *DustHistoryService {
+	return &DustHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *DustHistoryService) WithClient(client transport.HTTPClient) *DustHistoryService {
+	s.client = client
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *DustHistoryService) StartTime(ms int64) *DustHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *DustHistoryService) EndTime(ms int64) *DustHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Page sets the page number, starting at 1.
+func (s *DustHistoryService) Page(n int) *DustHistoryService {
+	s.page = &n
+	return s
+}
+
+// Limit sets the number of records per page.
+func (s *DustHistoryService) Limit(n int) *DustHistoryService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *DustHistoryService) RecvWindow(ms int64) *DustHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *DustHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("DustHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *DustHistoryService) Do(ctx context.Context) (*DustHistory, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/convert").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "DustHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[DustHistory](resp.Body, subsys, op)
+}
+
+func (s *DustHistoryService) validate() error {
+	var errs []string
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.page != nil && *s.page < 1 {
+		errs = append(errs, "page must be at least 1")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *DustHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.page != nil {
+		q.Add("page", strconv.Itoa(*s.page))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,178 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDustListService_Do_Success(t *testing.T) {
+	body := `[
+		{"convertMx":"0.000009","convertUsdt":"0.000009","balance":"0.000441","asset":"ETH"},
+		{"convertMx":"0.0012","convertUsdt":"0.0011","balance":"12","asset":"DOGE"}
+	]`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/convert/list")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewDustListService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 2)
+	assert.Equal(t, "ETH", result[0].Asset)
+	testutil.AssertDecimalEqual(t, result[0].Balance, "0.000441", "balance mismatch")
+	testutil.AssertDecimalEqual(t, result[1].ConvertMx, "0.0012", "convertMx mismatch")
+}
+
+func TestDustConvertService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewDustConvertService("api-key", "secret").Assets("ETH", "DOGE")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "ETH,DOGE", q.Get("asset"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestDustConvertService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDustConvertService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "DustConvertService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "at least one asset is required")
+
+	assets := strings.Split(strings.Repeat("A,", maxDustAssets+1), ",")[:maxDustAssets+1]
+	err = NewDustConvertService("", "").Assets(assets...).Validate()
+	assert.Contains(t, err.Error(), "at most 15 assets are allowed")
+
+	err = NewDustConvertService("", "").Assets("ETH", "").Validate()
+	assert.Contains(t, err.Error(), "asset must not be empty")
+}
+
+func TestDustConvertService_Do_Success(t *testing.T) {
+	body := `{"successList":["ETH"],"failedList":["DOGE"],"totalConvert":"0.07085578","convertFee":"0.00142"}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/convert")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewDustConvertService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Assets("ETH", "DOGE").
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, []string{"ETH"}, result.SuccessList)
+	assert.Equal(t, []string{"DOGE"}, result.FailedList)
+	testutil.AssertDecimalEqual(t, result.TotalConvert, "0.07085578", "total mismatch")
+	testutil.AssertDecimalEqual(t, result.ConvertFee, "0.00142", "fee mismatch")
+}
+
+func TestDustHistoryService_buildQuery(t *testing.T) {
+	svc := NewDustHistoryService("api-key", "secret").
+		StartTime(1000).
+		EndTime(2000).
+		Page(1).
+		Limit(100)
+	svc.timestamp = func() int64 { return 1620000000000 }
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "1", q.Get("page"))
+	assert.Equal(t, "100", q.Get("limit"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestDustHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewDustHistoryService("", "").Page(0).Limit(1001).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, "DustHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "page must be at least 1")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 1000")
+}
+
+func TestDustHistoryService_Do_Success(t *testing.T) {
+	body := `{
+		"data": [{
+			"totalConvert": "0.00885018",
+			"totalFee": "0.000177",
+			"convertTime": 1665360563000,
+			"convertDetails": [{
+				"id": "2bd4ec4ab01d4c9b8e1b2ef2a6c8b5b3",
+				"convert": "0.00885018",
+				"fee": "0.000177",
+				"amount": "0.0000005",
+				"time": 1665360563000,
+				"asset": "ETH"
+			}]
+		}],
+		"totalRecords": 1,
+		"page": 1,
+		"totalPageNum": 1
+	}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/convert")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewDustHistoryService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalRecords)
+	require.Len(t, result.Data, 1)
+
+	c := result.Data[0]
+	testutil.AssertDecimalEqual(t, c.TotalConvert, "0.00885018", "total mismatch")
+	assert.Equal(t, int64(1665360563000), c.ConvertTime)
+	require.Len(t, c.ConvertDetails, 1)
+	assert.Equal(t, "ETH", c.ConvertDetails[0].Asset)
+	testutil.AssertDecimalEqual(t, c.ConvertDetails[0].Amount, "0.0000005", "amount mismatch")
+}
+
+func TestDustHistoryService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewDustHistoryService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
@@ -0,0 +1,144 @@
+package wallet
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// DepositStatus represents the status of a deposit.
+type DepositStatus int
+
+const (
+	DepositStatusSmall      DepositStatus = 1
+	DepositStatusTimeDelay  DepositStatus = 2
+	DepositStatusLargeDelay DepositStatus = 3
+	DepositStatusPending    DepositStatus = 4
+	DepositStatusSuccess    DepositStatus = 5
+	DepositStatusAuditing   DepositStatus = 6
+	DepositStatusRejected   DepositStatus = 7
+	DepositStatusRefund     DepositStatus = 8
+	DepositStatusPreSuccess DepositStatus = 9
+	DepositStatusInvalid    DepositStatus = 10
+	DepositStatusRestricted DepositStatus = 11
+	DepositStatusCompleted  DepositStatus = 12
+)
+
+func (s DepositStatus) isValid() bool {
+	return s >= DepositStatusSmall && s <= DepositStatusCompleted
+}
+
+func (s *DepositStatus) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+
+	parsed := DepositStatus(n)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid deposit status: %d", n)
+	}
+
+	*s = parsed
+	return nil
+}
+
+// WithdrawStatus represents the status of a withdrawal.
+type WithdrawStatus int
+
+const (
+	WithdrawStatusApply         WithdrawStatus = 1
+	WithdrawStatusAuditing      WithdrawStatus = 2
+	WithdrawStatusWait          WithdrawStatus = 3
+	WithdrawStatusProcessing    WithdrawStatus = 4
+	WithdrawStatusWaitPackaging WithdrawStatus = 5
+	WithdrawStatusWaitConfirm   WithdrawStatus = 6
+	WithdrawStatusSuccess       WithdrawStatus = 7
+	WithdrawStatusFailed        WithdrawStatus = 8
+	WithdrawStatusCancel        WithdrawStatus = 9
+	WithdrawStatusManual        WithdrawStatus = 10
+)
+
+func (s WithdrawStatus) isValid() bool {
+	return s >= WithdrawStatusApply && s <= WithdrawStatusManual
+}
+
+func (s *WithdrawStatus) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err != nil {
+		return err
+	}
+
+	parsed := WithdrawStatus(n)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid withdraw status: %d", n)
+	}
+
+	*s = parsed
+	return nil
+}
+
+// AccountType represents an account of a universal transfer.
+type AccountType string
+
+const (
+	AccountTypeSpot           AccountType = "SPOT"
+	AccountTypeFutures        AccountType = "FUTURES"
+	AccountTypeIsolatedMargin AccountType = "ISOLATED_MARGIN"
+)
+
+func (t AccountType) isValid() bool {
+	switch t {
+	case AccountTypeSpot, AccountTypeFutures, AccountTypeIsolatedMargin:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *AccountType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed := AccountType(s)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid account type: %s", s)
+	}
+
+	*t = parsed
+	return nil
+}
+
+// InternalAccountType represents how the recipient of an internal transfer is identified.
+type InternalAccountType string
+
+const (
+	InternalAccountTypeEmail  InternalAccountType = "EMAIL"
+	InternalAccountTypeUID    InternalAccountType = "UID"
+	InternalAccountTypeMobile InternalAccountType = "MOBILE"
+)
+
+func (t InternalAccountType) isValid() bool {
+	switch t {
+	case InternalAccountTypeEmail, InternalAccountTypeUID, InternalAccountTypeMobile:
+		return true
+	default:
+		return false
+	}
+}
+
+func (t *InternalAccountType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	parsed := InternalAccountType(s)
+	if !parsed.isValid() {
+		return fmt.Errorf("invalid internal account type: %s", s)
+	}
+
+	*t = parsed
+	return nil
+}
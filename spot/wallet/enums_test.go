@@ -0,0 +1,73 @@
+package wallet
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDepositStatus_UnmarshalJSON(t *testing.T) {
+	var status DepositStatus
+
+	t.Run("valid status", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`5`), &status)
+		assert.NoError(t, err)
+		assert.Equal(t, DepositStatusSuccess, status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`99`), &status)
+		assert.Error(t, err)
+	})
+
+	t.Run("not a number", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"SUCCESS"`), &status)
+		assert.Error(t, err)
+	})
+}
+
+func TestWithdrawStatus_UnmarshalJSON(t *testing.T) {
+	var status WithdrawStatus
+
+	t.Run("valid status", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`7`), &status)
+		assert.NoError(t, err)
+		assert.Equal(t, WithdrawStatusSuccess, status)
+	})
+
+	t.Run("invalid status", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`0`), &status)
+		assert.Error(t, err)
+	})
+}
+
+func TestAccountType_UnmarshalJSON(t *testing.T) {
+	var accountType AccountType
+
+	t.Run("valid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"FUTURES"`), &accountType)
+		assert.NoError(t, err)
+		assert.Equal(t, AccountTypeFutures, accountType)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"MARGIN"`), &accountType)
+		assert.Error(t, err)
+	})
+}
+
+func TestInternalAccountType_UnmarshalJSON(t *testing.T) {
+	var accountType InternalAccountType
+
+	t.Run("valid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"UID"`), &accountType)
+		assert.NoError(t, err)
+		assert.Equal(t, InternalAccountTypeUID, accountType)
+	})
+
+	t.Run("invalid type", func(t *testing.T) {
+		err := json.Unmarshal([]byte(`"PHONE"`), &accountType)
+		assert.Error(t, err)
+	})
+}
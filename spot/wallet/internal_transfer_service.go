@@ -0,0 +1,376 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// InternalTransferResult represents the response of an internal transfer.
+type InternalTransferResult struct {
+	ID string `json:"id"`
+}
+
+// InternalTransferRecord represents an internal transfer record.
+type InternalTransferRecord struct {
+	TranID        string              `json:"tranId"`
+	Asset         string              `json:"asset"`
+	Amount        decimal.Decimal     `json:"amount"`
+	ToAccountType InternalAccountType `json:"toAccountType"`
+	ToAccount     string              `json:"toAccount"`
+	FromAccount   string              `json:"fromAccount"`
+	Status        string              `json:"status"`
+	Timestamp     int64               `json:"timestamp"`
+}
+
+// InternalTransferHistory represents a page of internal transfer records.
+type InternalTransferHistory struct {
+	Page         int                      `json:"page"`
+	TotalRecords int                      `json:"totalRecords"`
+	TotalPageNum int                      `json:"totalPageNum"`
+	Data         []InternalTransferRecord `json:"data"`
+}
+
+// InternalTransferService transfers an asset to another user of the exchange.
+type InternalTransferService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	toAccountType InternalAccountType
+	toAccount     string
+	areaCode      *string
+	asset         string
+	amount        decimal.Decimal
+	recvWindow    *int64
+	timestamp     func() int64
+}
+
+// NewInternalTransferService creates a new InternalTransferService.
+func NewInternalTransferService(apiKey, secretKey string) *InternalTransferService {
+	return &InternalTransferService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *InternalTransferService) WithClient(client transport.HTTPClient) *InternalTransferService {
+	s.client = client
+	return s
+}
+
+// ToAccountType sets how the recipient is identified.
+func (s *InternalTransferService) ToAccountType(t InternalAccountType) *InternalTransferService {
+	s.toAccountType = t
+	return s
+}
+
+// ToAccount sets the recipient UID, email or mobile number.
+func (s *InternalTransferService) ToAccount(account string) *InternalTransferService {
+	s.toAccount = account
+	return s
+}
+
+// AreaCode sets the area code of a mobile recipient.
+func (s *InternalTransferService) AreaCode(code string) *InternalTransferService {
+	s.areaCode = &code
+	return s
+}
+
+// Asset sets the asset to transfer.
+func (s *InternalTransferService) Asset(asset string) *InternalTransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount sets the amount to transfer.
+func (s *InternalTransferService) Amount(amount decimal.Decimal) *InternalTransferService {
+	s.amount = amount
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *InternalTransferService) RecvWindow(ms int64) *InternalTransferService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *InternalTransferService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("InternalTransferService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *InternalTransferService) Do(ctx context.Context) (*InternalTransferResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/transfer/internal").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "InternalTransferService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[InternalTransferResult](resp.Body, subsys, op)
+}
+
+func (s *InternalTransferService) validate() error {
+	var errs []string
+
+	if !s.toAccountType.isValid() {
+		errs = append(errs, "toAccountType is invalid")
+	}
+
+	if s.toAccount == "" {
+		errs = append(errs, "toAccount is required")
+	}
+
+	if s.toAccountType == InternalAccountTypeMobile && (s.areaCode == nil || *s.areaCode == "") {
+		errs = append(errs, "areaCode is required for mobile recipients")
+	}
+
+	if s.asset == "" {
+		errs = append(errs, "asset is required")
+	}
+
+	if !s.amount.IsPositive() {
+		errs = append(errs, "amount must be positive")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *InternalTransferService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("toAccountType", string(s.toAccountType))
+	q.Add("toAccount", s.toAccount)
+	q.Add("asset", s.asset)
+	q.Add("amount", s.amount.String())
+
+	if s.areaCode != nil {
+		q.Add("areaCode", *s.areaCode)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// InternalTransferHistoryService gets the internal transfer history.
+type InternalTransferHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	startTime  *int64
+	endTime    *int64
+	page       *int
+	limit      *int
+	tranID     *string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewInternalTransferHistoryService creates a new InternalTransferHistoryService.
+func NewInternalTransferHistoryService(apiKey, secretKey string) *InternalTransferHistoryService {
+	return &InternalTransferHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *InternalTransferHistoryService) WithClient(client transport.HTTPClient) *InternalTransferHistoryService {
+	s.client = client
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *InternalTransferHistoryService) StartTime(ms int64) *InternalTransferHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *InternalTransferHistoryService) EndTime(ms int64) *InternalTransferHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Page sets the page number, starting at 1.
+func (s *InternalTransferHistoryService) Page(n int) *InternalTransferHistoryService {
+	s.page = &n
+	return s
+}
+
+// Limit sets the number of records per page.
+func (s *InternalTransferHistoryService) Limit(n int) *InternalTransferHistoryService {
+	s.limit = &n
+	return s
+}
+
+// TranID limits the result to a single transfer.
+func (s *InternalTransferHistoryService) TranID(id string) *InternalTransferHistoryService {
+	s.tranID = &id
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *InternalTransferHistoryService) RecvWindow(ms int64) *InternalTransferHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *InternalTransferHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("InternalTransferHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *InternalTransferHistoryService) Do(ctx context.Context) (*InternalTransferHistory, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/transfer/internal").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "InternalTransferHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[InternalTransferHistory](resp.Body, subsys, op)
+}
+
+func (s *InternalTransferHistoryService) validate() error {
+	var errs []string
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.page != nil && *s.page < 1 {
+		errs = append(errs, "page must be at least 1")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 100) {
+		errs = append(errs, "limit must be between 1 and 100")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *InternalTransferHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.page != nil {
+		q.Add("page", strconv.Itoa(*s.page))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.tranID != nil {
+		q.Add("tranId", *s.tranID)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,174 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInternalTransferService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewInternalTransferService("api-key", "secret").
+		ToAccountType(InternalAccountTypeEmail).
+		ToAccount("user@example.com").
+		Asset("USDT").
+		Amount(decimal.NewFromInt(10))
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "EMAIL", q.Get("toAccountType"))
+	assert.Equal(t, "user@example.com", q.Get("toAccount"))
+	assert.Equal(t, "USDT", q.Get("asset"))
+	assert.Equal(t, "10", q.Get("amount"))
+	assert.False(t, q.Has("areaCode"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestInternalTransferService_Validate(t *testing.T) {
+	t.Run("missing fields", func(t *testing.T) {
+		err := NewInternalTransferService("", "").Validate()
+		require.Error(t, err)
+
+		sdkErr, ok := err.(*sdkerr.SDKError)
+		require.True(t, ok)
+		assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+		assert.Equal(t, "InternalTransferService.Validate", sdkErr.Op())
+		assert.Contains(t, sdkErr.Message(), "toAccountType is invalid")
+		assert.Contains(t, sdkErr.Message(), "toAccount is required")
+		assert.Contains(t, sdkErr.Message(), "asset is required")
+		assert.Contains(t, sdkErr.Message(), "amount must be positive")
+	})
+
+	t.Run("mobile requires area code", func(t *testing.T) {
+		svc := NewInternalTransferService("", "").
+			ToAccountType(InternalAccountTypeMobile).
+			ToAccount("5551234").
+			Asset("USDT").
+			Amount(decimal.NewFromInt(1))
+
+		err := svc.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "areaCode is required for mobile recipients")
+
+		assert.NoError(t, svc.AreaCode("1").Validate())
+	})
+}
+
+func TestInternalTransferService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/transfer/internal")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"id":"e6fd5ccd2e9b47e0a6d1a5fd2d1b4e4f"}`)}, nil
+		},
+	}
+
+	result, err := NewInternalTransferService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		ToAccountType(InternalAccountTypeUID).
+		ToAccount("12345678").
+		Asset("USDT").
+		Amount(decimal.NewFromInt(1)).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "e6fd5ccd2e9b47e0a6d1a5fd2d1b4e4f", result.ID)
+}
+
+func TestInternalTransferHistoryService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewInternalTransferHistoryService("api-key", "secret").
+		StartTime(1000).
+		EndTime(2000).
+		Page(1).
+		Limit(20).
+		TranID("abc")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "1", q.Get("page"))
+	assert.Equal(t, "20", q.Get("limit"))
+	assert.Equal(t, "abc", q.Get("tranId"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestInternalTransferHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewInternalTransferHistoryService("", "").StartTime(2).EndTime(1).Limit(0).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "InternalTransferHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "startTime must not be after endTime")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 100")
+}
+
+func TestInternalTransferHistoryService_Do_Success(t *testing.T) {
+	body := `{
+		"page": 1,
+		"totalRecords": 1,
+		"totalPageNum": 1,
+		"data": [{
+			"tranId": "11945860693",
+			"asset": "BTC",
+			"amount": "0.1",
+			"toAccountType": "EMAIL",
+			"toAccount": "156283619@outlook.com",
+			"fromAccount": "156283616@outlook.com",
+			"status": "SUCCESS",
+			"timestamp": 1544433325000
+		}]
+	}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/transfer/internal")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewInternalTransferHistoryService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.TotalRecords)
+	require.Len(t, result.Data, 1)
+
+	r := result.Data[0]
+	assert.Equal(t, InternalAccountTypeEmail, r.ToAccountType)
+	assert.Equal(t, "156283619@outlook.com", r.ToAccount)
+	testutil.AssertDecimalEqual(t, r.Amount, "0.1", "amount mismatch")
+}
+
+func TestInternalTransferHistoryService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewInternalTransferHistoryService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
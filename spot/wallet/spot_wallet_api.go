@@ -0,0 +1,6 @@
+package wallet
+
+const (
+	subsys         = "spot/wallet"
+	defaultBaseURL = "https://api.mexc.com"
+)
@@ -0,0 +1,396 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// TransferResult represents the response of a universal transfer.
+type TransferResult struct {
+	TranID string `json:"tranId"`
+}
+
+// TransferRecord represents a universal transfer record.
+type TransferRecord struct {
+	TranID          string          `json:"tranId"`
+	ClientTranID    string          `json:"clientTranId"`
+	Asset           string          `json:"asset"`
+	Amount          decimal.Decimal `json:"amount"`
+	FromAccountType AccountType     `json:"fromAccountType"`
+	ToAccountType   AccountType     `json:"toAccountType"`
+	FromSymbol      string          `json:"fromSymbol"`
+	ToSymbol        string          `json:"toSymbol"`
+	Status          string          `json:"status"`
+	Timestamp       int64           `json:"timestamp"`
+}
+
+// TransferHistory represents a page of universal transfer records.
+type TransferHistory struct {
+	Rows  []TransferRecord `json:"rows"`
+	Total int              `json:"total"`
+}
+
+// TransferService transfers an asset between accounts of the user.
+type TransferService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	fromAccountType AccountType
+	toAccountType   AccountType
+	asset           string
+	amount          decimal.Decimal
+	symbol          *string
+	recvWindow      *int64
+	timestamp       func() int64
+}
+
+// NewTransferService creates a new TransferService.
+func NewTransferService(apiKey, secretKey string) *TransferService {
+	return &TransferService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TransferService) WithClient(client transport.HTTPClient) *TransferService {
+	s.client = client
+	return s
+}
+
+// FromAccountType sets the source account.
+func (s *TransferService) FromAccountType(t AccountType) *TransferService {
+	s.fromAccountType = t
+	return s
+}
+
+// ToAccountType sets the destination account.
+func (s *TransferService) ToAccountType(t AccountType) *TransferService {
+	s.toAccountType = t
+	return s
+}
+
+// Asset sets the asset to transfer.
+func (s *TransferService) Asset(asset string) *TransferService {
+	s.asset = asset
+	return s
+}
+
+// Amount sets the amount to transfer.
+func (s *TransferService) Amount(amount decimal.Decimal) *TransferService {
+	s.amount = amount
+	return s
+}
+
+// Symbol sets the symbol of an isolated margin account.
+func (s *TransferService) Symbol(symbol string) *TransferService {
+	s.symbol = &symbol
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *TransferService) RecvWindow(ms int64) *TransferService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *TransferService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("TransferService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *TransferService) Do(ctx context.Context) (*TransferResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/transfer").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TransferService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[TransferResult](resp.Body, subsys, op)
+}
+
+func (s *TransferService) validate() error {
+	var errs []string
+
+	if !s.fromAccountType.isValid() {
+		errs = append(errs, "fromAccountType is invalid")
+	}
+
+	if !s.toAccountType.isValid() {
+		errs = append(errs, "toAccountType is invalid")
+	}
+
+	if s.fromAccountType != "" && s.fromAccountType == s.toAccountType {
+		errs = append(errs, "fromAccountType and toAccountType must differ")
+	}
+
+	if s.asset == "" {
+		errs = append(errs, "asset is required")
+	}
+
+	if !s.amount.IsPositive() {
+		errs = append(errs, "amount must be positive")
+	}
+
+	isolated := s.fromAccountType == AccountTypeIsolatedMargin || s.toAccountType == AccountTypeIsolatedMargin
+	if isolated && (s.symbol == nil || *s.symbol == "") {
+		errs = append(errs, "symbol is required for isolated margin transfers")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *TransferService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("fromAccountType", string(s.fromAccountType))
+	q.Add("toAccountType", string(s.toAccountType))
+	q.Add("asset", s.asset)
+	q.Add("amount", s.amount.String())
+
+	if s.symbol != nil {
+		q.Add("symbol", *s.symbol)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// TransferHistoryService gets the universal transfer history between two accounts.
+type TransferHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	fromAccountType AccountType
+	toAccountType   AccountType
+	startTime       *int64
+	endTime         *int64
+	page            *int
+	size            *int
+	recvWindow      *int64
+	timestamp       func() int64
+}
+
+// NewTransferHistoryService creates a new TransferHistoryService.
+func NewTransferHistoryService(apiKey, secretKey string) *TransferHistoryService {
+	return &TransferHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *TransferHistoryService) WithClient(client transport.HTTPClient) *TransferHistoryService {
+	s.client = client
+	return s
+}
+
+// FromAccountType sets the source account.
+func (s *TransferHistoryService) FromAccountType(t AccountType) *TransferHistoryService {
+	s.fromAccountType = t
+	return s
+}
+
+// ToAccountType sets the destination account.
+func (s *TransferHistoryService) ToAccountType(t AccountType) *TransferHistoryService {
+	s.toAccountType = t
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *TransferHistoryService) StartTime(ms int64) *TransferHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *TransferHistoryService) EndTime(ms int64) *TransferHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Page sets the page number, starting at 1.
+func (s *TransferHistoryService) Page(n int) *TransferHistoryService {
+	s.page = &n
+	return s
+}
+
+// Size sets the number of records per page.
+func (s *TransferHistoryService) Size(n int) *TransferHistoryService {
+	s.size = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *TransferHistoryService) RecvWindow(ms int64) *TransferHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *TransferHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("TransferHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *TransferHistoryService) Do(ctx context.Context) (*TransferHistory, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/transfer").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "TransferHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[TransferHistory](resp.Body, subsys, op)
+}
+
+func (s *TransferHistoryService) validate() error {
+	var errs []string
+
+	if !s.fromAccountType.isValid() {
+		errs = append(errs, "fromAccountType is invalid")
+	}
+
+	if !s.toAccountType.isValid() {
+		errs = append(errs, "toAccountType is invalid")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.page != nil && *s.page < 1 {
+		errs = append(errs, "page must be at least 1")
+	}
+
+	if s.size != nil && (*s.size < 1 || *s.size > 100) {
+		errs = append(errs, "size must be between 1 and 100")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *TransferHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("fromAccountType", string(s.fromAccountType))
+	q.Add("toAccountType", string(s.toAccountType))
+
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.page != nil {
+		q.Add("page", strconv.Itoa(*s.page))
+	}
+	if s.size != nil {
+		q.Add("size", strconv.Itoa(*s.size))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,197 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewTransferService("api-key", "secret").
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		Asset("USDT").
+		Amount(decimal.RequireFromString("250.5"))
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "SPOT", q.Get("fromAccountType"))
+	assert.Equal(t, "FUTURES", q.Get("toAccountType"))
+	assert.Equal(t, "USDT", q.Get("asset"))
+	assert.Equal(t, "250.5", q.Get("amount"))
+	assert.False(t, q.Has("symbol"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestTransferService_Validate(t *testing.T) {
+	t.Run("missing fields", func(t *testing.T) {
+		err := NewTransferService("", "").Validate()
+		require.Error(t, err)
+
+		sdkErr, ok := err.(*sdkerr.SDKError)
+		require.True(t, ok)
+		assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+		assert.Equal(t, "TransferService.Validate", sdkErr.Op())
+		assert.Contains(t, sdkErr.Message(), "fromAccountType is invalid")
+		assert.Contains(t, sdkErr.Message(), "toAccountType is invalid")
+		assert.Contains(t, sdkErr.Message(), "asset is required")
+		assert.Contains(t, sdkErr.Message(), "amount must be positive")
+	})
+
+	t.Run("same account", func(t *testing.T) {
+		err := NewTransferService("", "").
+			FromAccountType(AccountTypeSpot).
+			ToAccountType(AccountTypeSpot).
+			Asset("USDT").
+			Amount(decimal.NewFromInt(1)).
+			Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "fromAccountType and toAccountType must differ")
+	})
+
+	t.Run("isolated margin requires symbol", func(t *testing.T) {
+		svc := NewTransferService("", "").
+			FromAccountType(AccountTypeSpot).
+			ToAccountType(AccountTypeIsolatedMargin).
+			Asset("USDT").
+			Amount(decimal.NewFromInt(1))
+
+		err := svc.Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "symbol is required for isolated margin transfers")
+
+		assert.NoError(t, svc.Symbol("BTCUSDT").Validate())
+	})
+}
+
+func TestTransferService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/transfer")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"tranId":"c45d800a47ba4cbc876a5cd29388319"}`)}, nil
+		},
+	}
+
+	result, err := NewTransferService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		FromAccountType(AccountTypeFutures).
+		ToAccountType(AccountTypeSpot).
+		Asset("USDT").
+		Amount(decimal.NewFromInt(1)).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "c45d800a47ba4cbc876a5cd29388319", result.TranID)
+}
+
+func TestTransferService_Do_APIError(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return &transport.Response{StatusCode: 400, Body: []byte(`{"code":10206,"msg":"transfer disabled"}`)}, nil
+		},
+	}
+
+	result, err := NewTransferService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	require.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+	assert.True(t, errors.Is(err, errs.ErrTransferDisabled))
+}
+
+func TestTransferHistoryService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewTransferHistoryService("api-key", "secret").
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		StartTime(1000).
+		EndTime(2000).
+		Page(2).
+		Size(50)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "SPOT", q.Get("fromAccountType"))
+	assert.Equal(t, "FUTURES", q.Get("toAccountType"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "2", q.Get("page"))
+	assert.Equal(t, "50", q.Get("size"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestTransferHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewTransferHistoryService("", "").Page(0).Size(101).Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "TransferHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "fromAccountType is invalid")
+	assert.Contains(t, sdkErr.Message(), "page must be at least 1")
+	assert.Contains(t, sdkErr.Message(), "size must be between 1 and 100")
+}
+
+func TestTransferHistoryService_Do_Success(t *testing.T) {
+	body := `{
+		"rows": [{
+			"tranId": "11945860693",
+			"clientTranId": "test",
+			"asset": "USDT",
+			"amount": "1",
+			"fromAccountType": "SPOT",
+			"toAccountType": "FUTURES",
+			"fromSymbol": null,
+			"toSymbol": null,
+			"status": "SUCCESS",
+			"timestamp": 1544433325000
+		}],
+		"total": 1
+	}`
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/transfer")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(body)}, nil
+		},
+	}
+
+	result, err := NewTransferHistoryService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		FromAccountType(AccountTypeSpot).
+		ToAccountType(AccountTypeFutures).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, 1, result.Total)
+	require.Len(t, result.Rows, 1)
+
+	r := result.Rows[0]
+	assert.Equal(t, "11945860693", r.TranID)
+	assert.Equal(t, AccountTypeSpot, r.FromAccountType)
+	assert.Equal(t, AccountTypeFutures, r.ToAccountType)
+	testutil.AssertDecimalEqual(t, r.Amount, "1", "amount mismatch")
+	assert.Equal(t, "SUCCESS", r.Status)
+}
@@ -0,0 +1,208 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// Withdrawal represents a withdrawal record.
+type Withdrawal struct {
+	ID             string          `json:"id"`
+	TxID           string          `json:"txId"`
+	Coin           string          `json:"coin"`
+	Network        string          `json:"network"`
+	Address        string          `json:"address"`
+	Amount         decimal.Decimal `json:"amount"`
+	TransferType   int             `json:"transferType"`
+	Status         WithdrawStatus  `json:"status"`
+	TransactionFee decimal.Decimal `json:"transactionFee"`
+	ConfirmNo      int             `json:"confirmNo"`
+	ApplyTime      int64           `json:"applyTime"`
+	Remark         string          `json:"remark"`
+	Memo           string          `json:"memo"`
+	TransHash      string          `json:"transHash"`
+	UpdateTime     int64           `json:"updateTime"`
+	CoinID         string          `json:"coinId"`
+	VcoinID        string          `json:"vcoinId"`
+}
+
+// WithdrawHistoryService gets the withdrawal history.
+type WithdrawHistoryService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	coin       *string
+	status     *WithdrawStatus
+	startTime  *int64
+	endTime    *int64
+	limit      *int
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewWithdrawHistoryService creates a new WithdrawHistoryService.
+func NewWithdrawHistoryService(apiKey, secretKey string) *WithdrawHistoryService {
+	return &WithdrawHistoryService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *WithdrawHistoryService) WithClient(client transport.HTTPClient) *WithdrawHistoryService {
+	s.client = client
+	return s
+}
+
+// Coin limits the result to a single coin.
+func (s *WithdrawHistoryService) Coin(coin string) *WithdrawHistoryService {
+	s.coin = &coin
+	return s
+}
+
+// Status limits the result to withdrawals with the given status.
+func (s *WithdrawHistoryService) Status(status WithdrawStatus) *WithdrawHistoryService {
+	s.status = &status
+	return s
+}
+
+// StartTime sets the start time in milliseconds.
+func (s *WithdrawHistoryService) StartTime(ms int64) *WithdrawHistoryService {
+	s.startTime = &ms
+	return s
+}
+
+// EndTime sets the end time in milliseconds.
+func (s *WithdrawHistoryService) EndTime(ms int64) *WithdrawHistoryService {
+	s.endTime = &ms
+	return s
+}
+
+// Limit sets the number of records to return.
+func (s *WithdrawHistoryService) Limit(n int) *WithdrawHistoryService {
+	s.limit = &n
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *WithdrawHistoryService) RecvWindow(ms int64) *WithdrawHistoryService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *WithdrawHistoryService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("WithdrawHistoryService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *WithdrawHistoryService) Do(ctx context.Context) ([]Withdrawal, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodGet).
+		WithPath("/api/v3/capital/withdraw/history").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "WithdrawHistoryService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	withdrawals, err := spotapi.DecodeResponse[[]Withdrawal](resp.Body, subsys, op)
+	if err != nil {
+		return nil, err
+	}
+	return *withdrawals, nil
+}
+
+func (s *WithdrawHistoryService) validate() error {
+	var errs []string
+
+	if s.status != nil && !s.status.isValid() {
+		errs = append(errs, "status is invalid")
+	}
+
+	if s.startTime != nil && s.endTime != nil && *s.startTime > *s.endTime {
+		errs = append(errs, "startTime must not be after endTime")
+	}
+
+	if s.limit != nil && (*s.limit < 1 || *s.limit > 1000) {
+		errs = append(errs, "limit must be between 1 and 1000")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *WithdrawHistoryService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	if s.coin != nil {
+		q.Add("coin", *s.coin)
+	}
+	if s.status != nil {
+		q.Add("status", strconv.Itoa(int(*s.status)))
+	}
+	if s.startTime != nil {
+		q.Add("startTime", strconv.FormatInt(*s.startTime, 10))
+	}
+	if s.endTime != nil {
+		q.Add("endTime", strconv.FormatInt(*s.endTime, 10))
+	}
+	if s.limit != nil {
+		q.Add("limit", strconv.Itoa(*s.limit))
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
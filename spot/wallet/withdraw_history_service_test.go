@@ -0,0 +1,111 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const withdrawHistoryJSON = `[{
+	"id": "bb17a2d452684f00a523c015d512a341",
+	"txId": null,
+	"coin": "EOS",
+	"network": "EOS",
+	"address": "zzqqqqqqqqqq",
+	"amount": "10",
+	"transferType": 0,
+	"status": 3,
+	"transactionFee": "0",
+	"confirmNo": null,
+	"applyTime": 1665300874000,
+	"remark": "",
+	"memo": "MX10086",
+	"transHash": "0x0ced593b8b5adc9f600334d0d7335456a7ed772ea5547beda7ffc4f33a065c",
+	"updateTime": 1712134082000,
+	"coinId": "128f589271cb495b03e71e6323eb7be",
+	"vcoinId": "af42c6414b9a46c8869ce30fd51660f"
+}]`
+
+func TestWithdrawHistoryService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewWithdrawHistoryService("api-key", "secret").
+		Coin("EOS").
+		Status(WithdrawStatusWait).
+		StartTime(1000).
+		EndTime(2000).
+		Limit(20)
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "EOS", q.Get("coin"))
+	assert.Equal(t, "3", q.Get("status"))
+	assert.Equal(t, "1000", q.Get("startTime"))
+	assert.Equal(t, "2000", q.Get("endTime"))
+	assert.Equal(t, "20", q.Get("limit"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestWithdrawHistoryService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewWithdrawHistoryService("", "").
+		Status(WithdrawStatus(0)).
+		Limit(0).
+		Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "WithdrawHistoryService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "status is invalid")
+	assert.Contains(t, sdkErr.Message(), "limit must be between 1 and 1000")
+}
+
+func TestWithdrawHistoryService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/withdraw/history")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(withdrawHistoryJSON)}, nil
+		},
+	}
+
+	result, err := NewWithdrawHistoryService("API_KEY", "SECRET_KEY").WithClient(fakeClient).Do(context.Background())
+	assert.NoError(t, err)
+	require.Len(t, result, 1)
+
+	w := result[0]
+	assert.Equal(t, "bb17a2d452684f00a523c015d512a341", w.ID)
+	assert.Empty(t, w.TxID)
+	assert.Equal(t, WithdrawStatusWait, w.Status)
+	testutil.AssertDecimalEqual(t, w.Amount, "10", "amount mismatch")
+	testutil.AssertDecimalEqual(t, w.TransactionFee, "0", "fee mismatch")
+	assert.Equal(t, int64(1665300874000), w.ApplyTime)
+	assert.Equal(t, "MX10086", w.Memo)
+}
+
+func TestWithdrawHistoryService_Do_Errors(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			return nil, errors.New("network is down")
+		},
+	}
+
+	result, err := NewWithdrawHistoryService("", "").WithClient(fakeClient).Do(context.Background())
+	assert.Nil(t, result)
+
+	var sdkErr *sdkerr.SDKError
+	assert.ErrorAs(t, err, &sdkErr)
+	assert.Equal(t, sdkerr.ErrRequestFailed, sdkErr.Kind())
+}
@@ -0,0 +1,344 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/httpx"
+	"github.com/IvanTurko/mexc-sdk-go/internal/signature"
+	"github.com/IvanTurko/mexc-sdk-go/internal/spotapi"
+	"github.com/IvanTurko/mexc-sdk-go/internal/timeutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+)
+
+// WithdrawResult represents the response of a withdrawal submission or cancellation.
+type WithdrawResult struct {
+	ID string `json:"id"`
+}
+
+// WithdrawService submits a withdrawal.
+type WithdrawService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+
+	coin            string
+	address         string
+	amount          decimal.Decimal
+	withdrawOrderId *string
+	network         *string
+	contractAddress *string
+	memo            *string
+	remark          *string
+	recvWindow      *int64
+	timestamp       func() int64
+
+	requireWithdrawOrderId bool
+}
+
+// NewWithdrawService creates a new WithdrawService.
+func NewWithdrawService(apiKey, secretKey string) *WithdrawService {
+	return &WithdrawService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *WithdrawService) WithClient(client transport.HTTPClient) *WithdrawService {
+	s.client = client
+	return s
+}
+
+// Coin sets the coin to withdraw.
+func (s *WithdrawService) Coin(coin string) *WithdrawService {
+	s.coin = coin
+	return s
+}
+
+// Address sets the destination address.
+func (s *WithdrawService) Address(address string) *WithdrawService {
+	s.address = address
+	return s
+}
+
+// Amount sets the amount to withdraw.
+func (s *WithdrawService) Amount(amount decimal.Decimal) *WithdrawService {
+	s.amount = amount
+	return s
+}
+
+// WithdrawOrderId sets the client-supplied withdrawal id.
+func (s *WithdrawService) WithdrawOrderId(id string) *WithdrawService {
+	s.withdrawOrderId = &id
+	return s
+}
+
+// Network sets the network of the withdrawal.
+func (s *WithdrawService) Network(network string) *WithdrawService {
+	s.network = &network
+	return s
+}
+
+// ContractAddress sets the token contract address of the coin.
+func (s *WithdrawService) ContractAddress(address string) *WithdrawService {
+	s.contractAddress = &address
+	return s
+}
+
+// Memo sets the memo or tag of the destination address.
+func (s *WithdrawService) Memo(memo string) *WithdrawService {
+	s.memo = &memo
+	return s
+}
+
+// Remark sets a remark for the withdrawal.
+func (s *WithdrawService) Remark(remark string) *WithdrawService {
+	s.remark = &remark
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *WithdrawService) RecvWindow(ms int64) *WithdrawService {
+	s.recvWindow = &ms
+	return s
+}
+
+// RequireWithdrawOrderId makes validation fail when no withdrawOrderId is set.
+// A caller-supplied id lets the exchange reject duplicates, so a submission
+// can be retried safely after a timeout.
+func (s *WithdrawService) RequireWithdrawOrderId(enabled bool) *WithdrawService {
+	s.requireWithdrawOrderId = enabled
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *WithdrawService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("WithdrawService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *WithdrawService) Do(ctx context.Context) (*WithdrawResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodPost).
+		WithPath("/api/v3/capital/withdraw").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "WithdrawService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[WithdrawResult](resp.Body, subsys, op)
+}
+
+func (s *WithdrawService) validate() error {
+	var errs []string
+
+	if s.coin == "" {
+		errs = append(errs, "coin is required")
+	}
+
+	if s.address == "" {
+		errs = append(errs, "address is required")
+	}
+
+	if !s.amount.IsPositive() {
+		errs = append(errs, "amount must be positive")
+	}
+
+	if s.requireWithdrawOrderId && (s.withdrawOrderId == nil || *s.withdrawOrderId == "") {
+		errs = append(errs, "withdrawOrderId is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *WithdrawService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("coin", s.coin)
+	q.Add("address", s.address)
+	q.Add("amount", s.amount.String())
+
+	if s.withdrawOrderId != nil {
+		q.Add("withdrawOrderId", *s.withdrawOrderId)
+	}
+	if s.network != nil {
+		q.Add("netWork", *s.network)
+	}
+	if s.contractAddress != nil {
+		q.Add("contractAddress", *s.contractAddress)
+	}
+	if s.memo != nil {
+		q.Add("memo", *s.memo)
+	}
+	if s.remark != nil {
+		q.Add("remark", *s.remark)
+	}
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
+
+// CancelWithdrawService cancels a pending withdrawal.
+type CancelWithdrawService struct {
+	client     transport.HTTPClient
+	reqBuilder *spotapi.RequestBuilder
+	secretKey  string
+	id         string
+	recvWindow *int64
+	timestamp  func() int64
+}
+
+// NewCancelWithdrawService creates a new CancelWithdrawService.
+func NewCancelWithdrawService(apiKey, secretKey string) *CancelWithdrawService {
+	return &CancelWithdrawService{
+		client:     httpx.NewDefaultHTTPClient(),
+		reqBuilder: spotapi.NewRequestBuilder(defaultBaseURL, apiKey),
+		timestamp:  timeutil.NowMillis,
+		secretKey:  secretKey,
+	}
+}
+
+// WithClient sets the HTTP client for the service.
+func (s *CancelWithdrawService) WithClient(client transport.HTTPClient) *CancelWithdrawService {
+	s.client = client
+	return s
+}
+
+// ID sets the id of the withdrawal to cancel.
+func (s *CancelWithdrawService) ID(id string) *CancelWithdrawService {
+	s.id = id
+	return s
+}
+
+// RecvWindow sets the receive window for the request.
+func (s *CancelWithdrawService) RecvWindow(ms int64) *CancelWithdrawService {
+	s.recvWindow = &ms
+	return s
+}
+
+// Validate validates the service parameters.
+func (s *CancelWithdrawService) Validate() error {
+	if err := s.validate(); err != nil {
+		return sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp("CancelWithdrawService.Validate").
+			WithKind(sdkerr.ErrValidation).
+			WithMessage(err.Error())
+	}
+	return nil
+}
+
+// Do executes the service.
+func (s *CancelWithdrawService) Do(ctx context.Context) (*WithdrawResult, error) {
+	req := s.reqBuilder.
+		WithMethod(http.MethodDelete).
+		WithPath("/api/v3/capital/withdraw").
+		WithQuery(s.buildQuery()).
+		Build()
+
+	op := "CancelWithdrawService.Do"
+	resp, err := s.client.Do(ctx, req)
+	if err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrRequestFailed).
+			WithCause(err)
+	}
+
+	if err := spotapi.CheckResponseError(resp.StatusCode, resp.Body); err != nil {
+		return nil, sdkerr.NewSDKError().
+			WithSubsys(subsys).
+			WithOp(op).
+			WithKind(sdkerr.ErrAPIError).
+			WithCause(err)
+	}
+
+	return spotapi.DecodeResponse[WithdrawResult](resp.Body, subsys, op)
+}
+
+func (s *CancelWithdrawService) validate() error {
+	var errs []string
+
+	if s.id == "" {
+		errs = append(errs, "id is required")
+	}
+
+	if s.recvWindow != nil {
+		if *s.recvWindow < 1 || *s.recvWindow > 60000 {
+			errs = append(errs, "recvWindow must be between 1 and 60000")
+		}
+	}
+
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
+	return nil
+}
+
+func (s *CancelWithdrawService) buildQuery() url.Values {
+	q := make(url.Values)
+
+	q.Add("id", s.id)
+
+	if s.recvWindow != nil {
+		q.Add("recvWindow", strconv.FormatInt(*s.recvWindow, 10))
+	}
+
+	q.Add("timestamp", strconv.FormatInt(s.timestamp(), 10))
+
+	queryStr := q.Encode()
+	sig := signature.HMACSHA256(queryStr, s.secretKey)
+	q.Add("signature", sig)
+
+	return q
+}
@@ -0,0 +1,181 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/errs"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithdrawService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewWithdrawService("api-key", "secret").
+		Coin("USDT").
+		Address("TXyz").
+		Amount(decimal.RequireFromString("12.5")).
+		WithdrawOrderId("w-1").
+		Network("TRC20").
+		Memo("memo").
+		Remark("rent")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "USDT", q.Get("coin"))
+	assert.Equal(t, "TXyz", q.Get("address"))
+	assert.Equal(t, "12.5", q.Get("amount"))
+	assert.Equal(t, "w-1", q.Get("withdrawOrderId"))
+	assert.Equal(t, "TRC20", q.Get("netWork"))
+	assert.Equal(t, "memo", q.Get("memo"))
+	assert.Equal(t, "rent", q.Get("remark"))
+	assert.False(t, q.Has("contractAddress"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestWithdrawService_Validate(t *testing.T) {
+	valid := func() *WithdrawService {
+		return NewWithdrawService("", "").
+			Coin("USDT").
+			Address("TXyz").
+			Amount(decimal.NewFromInt(10))
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		assert.NoError(t, valid().Validate())
+	})
+
+	t.Run("missing fields", func(t *testing.T) {
+		err := NewWithdrawService("", "").Validate()
+		require.Error(t, err)
+
+		sdkErr, ok := err.(*sdkerr.SDKError)
+		require.True(t, ok)
+		assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+		assert.Equal(t, "WithdrawService.Validate", sdkErr.Op())
+		assert.Contains(t, sdkErr.Message(), "coin is required")
+		assert.Contains(t, sdkErr.Message(), "address is required")
+		assert.Contains(t, sdkErr.Message(), "amount must be positive")
+	})
+
+	t.Run("withdrawOrderId required", func(t *testing.T) {
+		err := valid().RequireWithdrawOrderId(true).Validate()
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "withdrawOrderId is required")
+
+		assert.NoError(t, valid().RequireWithdrawOrderId(true).WithdrawOrderId("w-1").Validate())
+	})
+}
+
+func TestWithdrawService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/withdraw")
+			assert.Equal(t, "API_KEY", req.Headers.Get("X-MEXC-APIKEY"))
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"id":"7213fea8e94b4a5593d507237e5a555b"}`)}, nil
+		},
+	}
+
+	result, err := NewWithdrawService("API_KEY", "SECRET_KEY").
+		WithClient(fakeClient).
+		Coin("USDT").
+		Address("TXyz").
+		Amount(decimal.NewFromInt(10)).
+		Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "7213fea8e94b4a5593d507237e5a555b", result.ID)
+}
+
+func TestWithdrawService_Do_APIError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want errs.ErrorCode
+	}{
+		{
+			name: "address invalid",
+			body: `{"code":10212,"msg":"This withdrawal address is not on the commonly used address list or has been invalidated"}`,
+			want: errs.ErrWithdrawalAddressInvalid,
+		},
+		{
+			name: "risk control",
+			body: `{"code":10265,"msg":"Due to risk control, withdrawal is unavailable, please try again later"}`,
+			want: errs.ErrWithdrawalUnavailableRiskControl,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient := &testutil.FakeHTTPClient{
+				DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+					return &transport.Response{StatusCode: 400, Body: []byte(tt.body)}, nil
+				},
+			}
+
+			result, err := NewWithdrawService("", "").WithClient(fakeClient).Do(context.Background())
+			assert.Nil(t, result)
+
+			var sdkErr *sdkerr.SDKError
+			require.ErrorAs(t, err, &sdkErr)
+			assert.Equal(t, sdkerr.ErrAPIError, sdkErr.Kind())
+			assert.True(t, errors.Is(err, tt.want))
+
+			var code errs.ErrorCode
+			require.ErrorAs(t, err, &code)
+			assert.True(t, code.IsTransferError())
+		})
+	}
+}
+
+func TestCancelWithdrawService_buildQuery(t *testing.T) {
+	mockTime := func() int64 { return 1620000000000 }
+
+	svc := NewCancelWithdrawService("api-key", "secret").ID("abc")
+	svc.timestamp = mockTime
+
+	q := svc.buildQuery()
+
+	assert.Equal(t, "abc", q.Get("id"))
+	assert.Equal(t, strconv.FormatInt(mockTime(), 10), q.Get("timestamp"))
+	assert.NotEmpty(t, q.Get("signature"))
+}
+
+func TestCancelWithdrawService_Validate_ErrorsWrapped(t *testing.T) {
+	err := NewCancelWithdrawService("", "").Validate()
+	assert.Error(t, err)
+
+	sdkErr, ok := err.(*sdkerr.SDKError)
+	assert.True(t, ok)
+	assert.Equal(t, sdkerr.ErrValidation, sdkErr.Kind())
+	assert.Equal(t, "CancelWithdrawService.Validate", sdkErr.Op())
+	assert.Contains(t, sdkErr.Message(), "id is required")
+}
+
+func TestCancelWithdrawService_Do_Success(t *testing.T) {
+	fakeClient := &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			assert.Equal(t, http.MethodDelete, req.Method)
+			assert.Contains(t, req.FullURL, "/api/v3/capital/withdraw")
+
+			return &transport.Response{StatusCode: 200, Body: []byte(`{"id":"abc"}`)}, nil
+		},
+	}
+
+	result, err := NewCancelWithdrawService("API_KEY", "SECRET_KEY").WithClient(fakeClient).ID("abc").Do(context.Background())
+	assert.NoError(t, err)
+	require.NotNil(t, result)
+	assert.Equal(t, "abc", result.ID)
+}
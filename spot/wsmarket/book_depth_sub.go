@@ -53,6 +53,12 @@ func (b *bookDepthSub) SetOnInvalid(f func(error)) Subscription {
 	return b
 }
 
+func (b *bookDepthSub) reportInvalid(err error) {
+	if b.onInvalid != nil {
+		b.onInvalid(err)
+	}
+}
+
 func (b *bookDepthSub) matches(msg *message) (bool, error) {
 	return msg.Msg == b.streamName, nil
 }
@@ -46,6 +46,12 @@ func (b *bookTickerBatchSub) SetOnInvalid(f func(error)) Subscription {
 	return b
 }
 
+func (b *bookTickerBatchSub) reportInvalid(err error) {
+	if b.onInvalid != nil {
+		b.onInvalid(err)
+	}
+}
+
 func (b *bookTickerBatchSub) matches(msg *message) (bool, error) {
 	return msg.Msg == b.streamName, nil
 }
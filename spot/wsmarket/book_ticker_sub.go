@@ -51,6 +51,12 @@ func (b *bookTickerSub) SetOnInvalid(f func(error)) Subscription {
 	return b
 }
 
+func (b *bookTickerSub) reportInvalid(err error) {
+	if b.onInvalid != nil {
+		b.onInvalid(err)
+	}
+}
+
 func (b *bookTickerSub) matches(msg *message) (bool, error) {
 	return msg.Msg == b.streamName, nil
 }
@@ -47,6 +47,12 @@ func (d *diffDepthBatchSub) SetOnInvalid(f func(error)) Subscription {
 	return d
 }
 
+func (d *diffDepthBatchSub) reportInvalid(err error) {
+	if d.onInvalid != nil {
+		d.onInvalid(err)
+	}
+}
+
 func (d *diffDepthBatchSub) matches(msg *message) (bool, error) {
 	return msg.Msg == d.streamName, nil
 }
@@ -72,6 +78,12 @@ func (d *diffDepthBatchSub) handleEvent(msg *PushDataV3MarketWrapper) {
 	d.onData(res)
 }
 
+// invalidate reports lost frames through the onInvalid callback, since the
+// depth state can no longer be trusted.
+func (d *diffDepthBatchSub) invalidate(err error) {
+	d.reportInvalid(err)
+}
+
 func (d *diffDepthBatchSub) id() string {
 	return d.streamName
 }
@@ -53,6 +53,12 @@ func (d *diffDepthSub) SetOnInvalid(f func(error)) Subscription {
 	return d
 }
 
+func (d *diffDepthSub) reportInvalid(err error) {
+	if d.onInvalid != nil {
+		d.onInvalid(err)
+	}
+}
+
 func (d *diffDepthSub) matches(msg *message) (bool, error) {
 	return msg.Msg == d.streamName, nil
 }
@@ -78,6 +84,12 @@ func (d *diffDepthSub) handleEvent(msg *PushDataV3MarketWrapper) {
 	d.onData(res)
 }
 
+// invalidate reports lost frames through the onInvalid callback, since the
+// depth state can no longer be trusted.
+func (d *diffDepthSub) invalidate(err error) {
+	d.reportInvalid(err)
+}
+
 func (d *diffDepthSub) id() string {
 	return d.streamName
 }
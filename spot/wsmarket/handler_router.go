@@ -8,9 +8,16 @@ type handlerRouter interface {
 	Register(h wsHandler)
 	Unregister(h wsHandler)
 	Route(msg *PushDataV3MarketWrapper)
+	Invalidate(err error)
 	Len() int
 }
 
+// invalidator is implemented by subscriptions whose state depends on seeing
+// every frame, such as depth streams.
+type invalidator interface {
+	invalidate(err error)
+}
+
 type handlerRouterImp struct {
 	mu       sync.RWMutex
 	handlers map[wsHandler]struct{}
@@ -44,6 +51,18 @@ func (r *handlerRouterImp) Route(msg *PushDataV3MarketWrapper) {
 	}
 }
 
+// Invalidate notifies every handler implementing invalidator that frames
+// were lost.
+func (r *handlerRouterImp) Invalidate(err error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for h := range r.handlers {
+		if inv, ok := h.(invalidator); ok {
+			inv.invalidate(err)
+		}
+	}
+}
+
 func (r *handlerRouterImp) Len() int {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
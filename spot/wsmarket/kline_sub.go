@@ -77,6 +77,12 @@ func (k *klineSub) SetOnInvalid(f func(error)) Subscription {
 	return k
 }
 
+func (k *klineSub) reportInvalid(err error) {
+	if k.onInvalid != nil {
+		k.onInvalid(err)
+	}
+}
+
 func (k *klineSub) matches(msg *message) (bool, error) {
 	return msg.Msg == k.streamName, nil
 }
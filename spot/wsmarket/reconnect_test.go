@@ -0,0 +1,252 @@
+package wsmarket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// ackingClient acknowledges every subscription request.
+func ackingClient() *testutil.PipeClient {
+	c := testutil.NewPipeClient()
+	c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+		var req wsRequestPayload
+		if err := json.Unmarshal(msg, &req); err != nil || req.ID == nil {
+			return
+		}
+		c.Push([]byte(fmt.Sprintf(`{"id":%d,"code":0,"msg":%q}`, *req.ID, req.Params[0])))
+	}
+	return c
+}
+
+type stateRecorder struct {
+	mu     sync.Mutex
+	events []ws.StateEvent
+}
+
+func (r *stateRecorder) record(e ws.StateEvent) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.events = append(r.events, e)
+}
+
+func (r *stateRecorder) states() []ws.ConnState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []ws.ConnState
+	for _, e := range r.events {
+		out = append(out, e.State)
+	}
+	return out
+}
+
+func TestWSMarket_Reconnect_Resubscribes(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		clients []*testutil.PipeClient
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		c := ackingClient()
+		clients = append(clients, c)
+		return c
+	}
+	client := func(i int) *testutil.PipeClient {
+		mu.Lock()
+		defer mu.Unlock()
+		if i >= len(clients) {
+			return nil
+		}
+		return clients[i]
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(states.record),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+
+	handle, err := w.Subscribe(context.Background(), NewDiffDepthSub("BTCUSDT", Update100ms, func(*DepthDelta) {}))
+	require.NoError(t, err)
+
+	_ = client(0).Close()
+
+	require.Eventually(t, func() bool {
+		s := states.states()
+		return s[len(s)-1] == ws.StateConnected && client(1) != nil
+	}, time.Second, time.Millisecond)
+	assert.Contains(t, states.states(), ws.StateReconnecting)
+
+	writes := client(1).Writes()
+	require.Len(t, writes, 1)
+	assert.True(t, strings.Contains(string(writes[0]), "spot@public.aggre.depth.v3.api.pb@100ms@BTCUSDT"))
+
+	require.NoError(t, handle.Unsubscribe(context.Background()))
+	assert.Contains(t, string(client(1).Writes()[1]), "UNSUBSCRIPTION")
+
+	require.NoError(t, w.Close())
+	s := states.states()
+	assert.Equal(t, ws.StateClosed, s[len(s)-1])
+}
+
+func TestWSMarket_Reconnect_GivesUpAfterMaxAttempts(t *testing.T) {
+	first := testutil.NewPipeClient()
+	calls := 0
+	factory := func(url string) ws.Client {
+		calls++
+		if calls == 1 {
+			return first
+		}
+		c := testutil.NewPipeClient()
+		c.ConnectErr = fakeErr
+		return c
+	}
+
+	closed := make(chan ws.StateEvent, 1)
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond, MaxAttempts: 2}),
+		WithOnStateChange(func(e ws.StateEvent) {
+			if e.State == ws.StateClosed {
+				closed <- e
+			}
+		}),
+	)
+
+	require.NoError(t, w.Connect(context.Background()))
+	_ = first.Close()
+
+	select {
+	case e := <-closed:
+		assert.ErrorIs(t, e.Err, fakeErr)
+	case <-time.After(time.Second):
+		t.Fatal("client did not give up")
+	}
+	assert.Equal(t, 3, calls)
+}
+
+func TestWSMarket_Reconnect_FailedResubscribeCountsAsAttempt(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return ackingClient()
+		}
+		// Connects, then fails the resubscribe write.
+		c := testutil.NewPipeClient()
+		_ = c.Close()
+		return c
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond, MaxAttempts: 2}),
+		WithOnStateChange(states.record),
+	)
+	require.NoError(t, w.Connect(context.Background()))
+
+	_, err := w.Subscribe(context.Background(), NewDiffDepthSub("BTCUSDT", Update100ms, func(*DepthDelta) {}))
+	require.NoError(t, err)
+
+	_ = w.getClient().Close()
+
+	select {
+	case <-w.Done():
+	case <-time.After(time.Second):
+		t.Fatal("client did not give up")
+	}
+	assert.ErrorIs(t, w.Err(), testutil.ErrPipeClosed)
+
+	s := states.states()
+	assert.Equal(t, ws.StateClosed, s[len(s)-1])
+
+	time.Sleep(20 * time.Millisecond)
+	mu.Lock()
+	assert.Equal(t, 3, calls)
+	mu.Unlock()
+}
+
+func TestWSMarket_Reconnect_RejectedResubscribeInvalidatesSubscription(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls int
+	)
+	factory := func(url string) ws.Client {
+		mu.Lock()
+		defer mu.Unlock()
+		calls++
+		if calls == 1 {
+			return ackingClient()
+		}
+		// Rejects BTCUSDT and acknowledges everything else.
+		c := testutil.NewPipeClient()
+		c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+			var req wsRequestPayload
+			if err := json.Unmarshal(msg, &req); err != nil || req.ID == nil {
+				return
+			}
+			if strings.Contains(fmt.Sprint(req.Params[0]), "BTCUSDT") {
+				c.Push([]byte(fmt.Sprintf(`{"id":%d,"code":1,"msg":"rejected"}`, *req.ID)))
+				return
+			}
+			c.Push([]byte(fmt.Sprintf(`{"id":%d,"code":0,"msg":%q}`, *req.ID, req.Params[0])))
+		}
+		return c
+	}
+
+	states := &stateRecorder{}
+	w := NewWSMarketWithFactory(factory,
+		WithReconnect(ws.Backoff{Initial: time.Millisecond}),
+		WithOnStateChange(states.record),
+	)
+	require.NoError(t, w.Connect(context.Background()))
+	defer w.Close()
+
+	invalid := make(chan error, 1)
+	rejected, err := w.Subscribe(context.Background(), NewDiffDepthSub("BTCUSDT", Update100ms, func(*DepthDelta) {}).
+		SetOnInvalid(func(err error) { invalid <- err }))
+	require.NoError(t, err)
+	_, err = w.Subscribe(context.Background(), NewDiffDepthSub("ETHUSDT", Update100ms, func(*DepthDelta) {}))
+	require.NoError(t, err)
+
+	_ = w.getClient().Close()
+
+	select {
+	case err := <-invalid:
+		assert.ErrorIs(t, err, sdkerr.ErrWSServerError)
+	case <-time.After(time.Second):
+		t.Fatal("rejected subscription not invalidated")
+	}
+
+	require.Eventually(t, func() bool {
+		s := states.states()
+		return s[len(s)-1] == ws.StateConnected
+	}, time.Second, time.Millisecond)
+	assert.NoError(t, w.Err())
+
+	w.activeSubsMu.Lock()
+	assert.Len(t, w.activeSubs, 1)
+	w.activeSubsMu.Unlock()
+
+	assert.NoError(t, rejected.Unsubscribe(context.Background()))
+	mu.Lock()
+	assert.Equal(t, 2, calls)
+	mu.Unlock()
+}
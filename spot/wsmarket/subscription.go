@@ -12,6 +12,8 @@ type wsHandler interface {
 type subscriptionSpec interface {
 	wsHandler
 	id() string
+	// reportInvalid passes err to the onInvalid callback, if any.
+	reportInvalid(err error)
 	params() any
 	matches(msg *message) (bool, error)
 }
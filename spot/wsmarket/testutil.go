@@ -22,6 +22,9 @@ func (m *mockHandlerRouter) Unregister(h wsHandler) {
 func (m *mockHandlerRouter) Route(msg *PushDataV3MarketWrapper) {
 }
 
+func (m *mockHandlerRouter) Invalidate(err error) {
+}
+
 func (m *mockHandlerRouter) Len() int {
 	return m.len
 }
@@ -34,6 +37,8 @@ func (m *mockSubscription) SetOnInvalid(f func(error)) Subscription {
 	return m
 }
 
+func (m *mockSubscription) reportInvalid(err error) {}
+
 func (m *mockSubscription) matches(msg *message) (bool, error) {
 	return false, nil
 }
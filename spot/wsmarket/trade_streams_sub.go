@@ -60,6 +60,12 @@ func (t *tradeStreamsSub) SetOnInvalid(f func(error)) Subscription {
 	return t
 }
 
+func (t *tradeStreamsSub) reportInvalid(err error) {
+	if t.onInvalid != nil {
+		t.onInvalid(err)
+	}
+}
+
 func (t *tradeStreamsSub) matches(msg *message) (bool, error) {
 	return msg.Msg == t.streamName, nil
 }
@@ -0,0 +1,638 @@
+package wsuser
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/wsutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/spot/wsuser/keyservice"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+)
+
+const (
+	defaultKeepAliveInterval = 30 * time.Minute
+	// A listen key is valid for 24 hours at most, so it is replaced a bit
+	// earlier.
+	defaultKeyRotation = 23 * time.Hour
+)
+
+var (
+	// ErrSessionStarted is returned by Session.Start if the session is already running.
+	ErrSessionStarted = errors.New("session already started")
+	// ErrSessionClosed is returned when using a closed Session.
+	ErrSessionClosed = errors.New("session closed")
+)
+
+// SessionOptions configures Session.
+type SessionOptions = func(*Session)
+
+// Session is a self-healing SPOT user data stream.
+//
+// It generates a listen key, keeps it alive on a schedule and rotates it
+// before the 24h limit. When the key expires or the connection drops, the
+// session reconnects with a fresh key, restores its subscriptions and closes
+// the old key.
+type Session struct {
+	apiKey    string
+	secretKey string
+
+	httpClient        transport.HTTPClient
+	factory           func(url string) ws.Client
+	userOpts          []Options
+	keepAliveInterval time.Duration
+	keyRotation       time.Duration
+	backoff           ws.Backoff
+	onStateChange     func(ws.StateEvent)
+	onError           func(error)
+
+	mu         sync.Mutex
+	user       *WSUser
+	userCancel context.CancelFunc
+	listenKey  string
+	subs       map[string]*sessionSubscription
+	started    bool
+	closed     bool
+
+	// generation identifies the current WSUser so that disconnects of
+	// replaced connections are ignored.
+	generation  atomic.Uint64
+	disconnects chan error
+
+	cancel    context.CancelFunc
+	done      chan struct{}
+	life      wsutil.Lifecycle
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// NewSession creates a Session using the default WebSocket client.
+// Additional configuration can be supplied through SessionOptions.
+// Panics if apiKey or secretKey is empty.
+func NewSession(apiKey, secretKey string, opts ...SessionOptions) *Session {
+	if apiKey == "" || secretKey == "" {
+		panic("NewSession: apiKey and secretKey are required")
+	}
+
+	s := &Session{
+		apiKey:    apiKey,
+		secretKey: secretKey,
+
+		keepAliveInterval: defaultKeepAliveInterval,
+		keyRotation:       defaultKeyRotation,
+		backoff:           ws.DefaultBackoff(),
+
+		subs:        make(map[string]*sessionSubscription),
+		disconnects: make(chan error, 1),
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// WithSessionHTTPClient sets the HTTP client used for listen key requests.
+func WithSessionHTTPClient(client transport.HTTPClient) SessionOptions {
+	return func(s *Session) {
+		s.httpClient = client
+	}
+}
+
+// WithSessionFactory sets the ws.Client factory used for every connection.
+// By default connections are created with ws.NewClient and the
+// WithClientOptions passed via WithSessionUserOptions.
+func WithSessionFactory(factory func(url string) ws.Client) SessionOptions {
+	return func(s *Session) {
+		if factory != nil {
+			s.factory = factory
+		}
+	}
+}
+
+// WithSessionUserOptions sets Options applied to every underlying WSUser.
+// A WithOnDisconnect among them is overridden by the session.
+func WithSessionUserOptions(opts ...Options) SessionOptions {
+	return func(s *Session) {
+		s.userOpts = opts
+	}
+}
+
+// WithKeepAliveInterval sets how often the listen key is extended (default 30m).
+func WithKeepAliveInterval(d time.Duration) SessionOptions {
+	return func(s *Session) {
+		if d > 0 {
+			s.keepAliveInterval = d
+		}
+	}
+}
+
+// WithKeyRotation sets how long a listen key is used before it is replaced
+// (default 23h).
+func WithKeyRotation(d time.Duration) SessionOptions {
+	return func(s *Session) {
+		if d > 0 {
+			s.keyRotation = d
+		}
+	}
+}
+
+// WithSessionBackoff sets the delays between reconnect attempts.
+func WithSessionBackoff(b ws.Backoff) SessionOptions {
+	return func(s *Session) {
+		s.backoff = b
+	}
+}
+
+// WithSessionOnStateChange registers a callback for connection state changes.
+func WithSessionOnStateChange(f func(ws.StateEvent)) SessionOptions {
+	return func(s *Session) {
+		s.onStateChange = f
+	}
+}
+
+// WithSessionOnError registers a callback for errors that do not stop the
+// session, such as a failed keepalive or a failed close of an old key.
+func WithSessionOnError(f func(error)) SessionOptions {
+	return func(s *Session) {
+		s.onError = f
+	}
+}
+
+// Start generates a listen key, connects and subscribes every subscription
+// registered so far. The session keeps running until Close is called or
+// reconnecting gives up.
+func (s *Session) Start(ctx context.Context) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		return s.errFactory("Start", nil, ErrSessionClosed)
+	}
+	if s.started {
+		s.mu.Unlock()
+		return s.errFactory("Start", nil, ErrSessionStarted)
+	}
+	s.started = true
+	s.mu.Unlock()
+
+	s.publishState(ws.StateEvent{State: ws.StateConnecting})
+
+	runCtx, cancel := context.WithCancel(context.Background())
+	if err := s.open(ctx, runCtx); err != nil {
+		cancel()
+		s.mu.Lock()
+		if !s.closed {
+			s.started = false
+		}
+		s.mu.Unlock()
+		return err
+	}
+
+	done := make(chan struct{})
+	s.mu.Lock()
+	if s.closed {
+		// Close ran after open stored the connection and has already torn
+		// it down, but could not see cancel.
+		s.mu.Unlock()
+		cancel()
+		return s.errFactory("Start", nil, ErrSessionClosed)
+	}
+	s.cancel, s.done = cancel, done
+	s.mu.Unlock()
+
+	s.publishState(ws.StateEvent{State: ws.StateConnected})
+
+	go s.supervise(runCtx, done)
+	return nil
+}
+
+// Close stops the session, closes the connection and the listen key.
+// Safe to call multiple times.
+func (s *Session) Close(ctx context.Context) error {
+	s.mu.Lock()
+	cancel, done := s.cancel, s.done
+	s.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+	return s.shutdown(ctx, nil)
+}
+
+// ListenKey returns the listen key currently in use, or "" if not connected.
+func (s *Session) ListenKey() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.listenKey
+}
+
+// Subscribe registers sub with the session. It is subscribed immediately if
+// the session is running and restored after every reconnect.
+//
+// Errors:
+//   - ErrDuplicateSubscription: subscription with the same key already exists.
+//   - ErrMaxCountSubscribes: subscription limit exceeded (max 3 for user streams).
+//   - ErrSessionClosed: the session was closed.
+//
+// Panics if sub is nil.
+func (s *Session) Subscribe(ctx context.Context, sub Subscription) (SubscriptionHandle, error) {
+	if nil == sub {
+		panic("Session.Subscribe: subscribe must not be nil")
+	}
+
+	subID := sub.id()
+
+	s.mu.Lock()
+
+	if s.closed {
+		s.mu.Unlock()
+		return nil, s.errFactory("Subscribe", nil, ErrSessionClosed)
+	}
+
+	if _, ok := s.subs[subID]; ok {
+		s.mu.Unlock()
+		return nil, s.errFactory("Subscribe", nil, ErrDuplicateSubscription).
+			WithMessage(fmt.Sprintf("already subscribed: %s", subID))
+	}
+
+	if len(s.subs) >= maxCountSubscribes {
+		s.mu.Unlock()
+		return nil, s.errFactory("Subscribe", nil, ErrMaxCountSubscribes).
+			WithMessage(fmt.Sprintf("subscription limit exceeded (max allowed: %d)", maxCountSubscribes))
+	}
+
+	entry := &sessionSubscription{session: s, inner: sub}
+	// Reserve the slot; the request itself is sent without holding the lock.
+	s.subs[subID] = entry
+	user := s.user
+	s.mu.Unlock()
+
+	if user == nil {
+		return entry, nil
+	}
+
+	handle, err := user.Subscribe(ctx, sub)
+
+	s.mu.Lock()
+	closed := s.closed
+	current := s.subs[subID] == entry
+	// After a swap, open has already subscribed entry on the new
+	// connection and handle belongs to the closed one.
+	swapped := s.user != user
+	if current && !swapped {
+		if err == nil {
+			entry.handle = handle
+		} else {
+			delete(s.subs, subID)
+		}
+	}
+	s.mu.Unlock()
+
+	switch {
+	case closed:
+		return nil, s.errFactory("Subscribe", nil, ErrSessionClosed)
+	case swapped:
+		return entry, nil
+	case err != nil:
+		return nil, err
+	case !current:
+		// Unsubscribe ran while the request was in flight.
+		_ = handle.Unsubscribe(ctx)
+	}
+	return entry, nil
+}
+
+type sessionSubscription struct {
+	session *Session
+	inner   Subscription
+	handle  SubscriptionHandle
+	once    sync.Once
+	err     error
+}
+
+// reject reports through the onInvalid callback that the server refused the
+// subscription. It does nothing after Unsubscribe.
+func (s *sessionSubscription) reject(err error) {
+	rejected := false
+	s.once.Do(func() {
+		rejected = true
+	})
+	if rejected {
+		s.inner.reportInvalid(err)
+	}
+}
+
+// Unsubscribe removes the subscription from the session. Safe to call multiple times.
+func (s *sessionSubscription) Unsubscribe(ctx context.Context) error {
+	s.once.Do(func() {
+		s.session.mu.Lock()
+		if s.session.subs[s.inner.id()] == s {
+			delete(s.session.subs, s.inner.id())
+		}
+		handle := s.handle
+		s.handle = nil
+		s.session.mu.Unlock()
+
+		if handle != nil {
+			s.err = handle.Unsubscribe(ctx)
+		}
+	})
+	return s.err
+}
+
+// open connects a WSUser with a fresh listen key, subscribes every registered
+// subscription and makes it the current one. The replaced WSUser and its key
+// are closed afterwards. A subscription the server rejects is removed and
+// reported through its onInvalid callback. ctx bounds the requests, runCtx
+// the connection.
+func (s *Session) open(ctx, runCtx context.Context) error {
+	key, err := s.generateKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	prevGen := s.generation.Load()
+	gen := prevGen + 1
+
+	userCtx, userCancel := context.WithCancel(runCtx)
+	opts := append(append([]Options(nil), s.userOpts...),
+		WithLifetime(userCtx),
+		WithOnDisconnect(func(err error) {
+			s.handleDisconnect(gen, err)
+		}),
+	)
+	user := newWSUser(key, s.factory, opts...)
+
+	fail := func(err error) error {
+		s.generation.Store(prevGen)
+		userCancel()
+		_ = user.Close()
+		s.closeKey(ctx, key)
+		return err
+	}
+
+	s.generation.Store(gen)
+	if err := user.Connect(ctx); err != nil {
+		return fail(err)
+	}
+
+	// Subscribe without holding the lock, repeating until every registered
+	// subscription is covered; Subscribe may add entries meanwhile. Rejected
+	// entries are kept with a nil handle.
+	handles := make(map[*sessionSubscription]SubscriptionHandle)
+	rejected := make(map[*sessionSubscription]error)
+	for {
+		s.mu.Lock()
+		if s.closed {
+			s.mu.Unlock()
+			return fail(s.errFactory("open", nil, ErrSessionClosed))
+		}
+		var missing []*sessionSubscription
+		for _, entry := range s.subs {
+			if _, ok := handles[entry]; !ok {
+				missing = append(missing, entry)
+			}
+		}
+		if len(missing) == 0 {
+			break
+		}
+		s.mu.Unlock()
+
+		for _, entry := range missing {
+			handle, err := user.Subscribe(ctx, entry.inner)
+			if errors.Is(err, sdkerr.ErrWSServerError) {
+				handles[entry] = nil
+				rejected[entry] = err
+				continue
+			}
+			if err != nil {
+				return fail(err)
+			}
+			handles[entry] = handle
+		}
+	}
+
+	// s.mu is held from the last check above.
+	var stale []SubscriptionHandle
+	for entry, handle := range handles {
+		current := s.subs[entry.inner.id()] == entry
+		switch {
+		case handle == nil:
+			if current {
+				delete(s.subs, entry.inner.id())
+			}
+		case current:
+			entry.handle = handle
+		default:
+			stale = append(stale, handle)
+		}
+	}
+
+	oldUser, oldCancel, oldKey := s.user, s.userCancel, s.listenKey
+	s.user, s.userCancel, s.listenKey = user, userCancel, key
+	s.mu.Unlock()
+
+	for _, handle := range stale {
+		_ = handle.Unsubscribe(ctx)
+	}
+	for entry, err := range rejected {
+		entry.reject(err)
+	}
+
+	if oldUser != nil {
+		oldCancel()
+		_ = oldUser.Close()
+		s.closeKey(ctx, oldKey)
+	}
+	return nil
+}
+
+func (s *Session) handleDisconnect(gen uint64, err error) {
+	if s.generation.Load() != gen {
+		return
+	}
+
+	select {
+	case s.disconnects <- err:
+	default:
+	}
+}
+
+// supervise keeps the listen key alive and replaces the connection on
+// rotation, keepalive failure or disconnect.
+func (s *Session) supervise(ctx context.Context, done chan struct{}) {
+	defer close(done)
+
+	keepAlive := time.NewTicker(s.keepAliveInterval)
+	defer keepAlive.Stop()
+
+	rotate := time.NewTimer(s.keyRotation)
+	defer rotate.Stop()
+
+	for {
+		var cause error
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-keepAlive.C:
+			err := s.keepAlive(ctx)
+			if err == nil {
+				continue
+			}
+			s.reportError(err)
+			cause = err
+		case <-rotate.C:
+		case cause = <-s.disconnects:
+		}
+
+		if err := s.renew(ctx, cause); err != nil {
+			_ = s.shutdown(context.Background(), err)
+			return
+		}
+
+		keepAlive.Reset(s.keepAliveInterval)
+		rotate.Reset(s.keyRotation)
+	}
+}
+
+// renew replaces the current connection, retrying with backoff. cause is nil
+// for a scheduled key rotation.
+func (s *Session) renew(ctx context.Context, cause error) error {
+	for attempt := 1; ; attempt++ {
+		if s.backoff.Exhausted(attempt) {
+			return cause
+		}
+
+		s.publishState(ws.StateEvent{State: ws.StateReconnecting, Attempt: attempt, Err: cause})
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(s.backoff.Delay(attempt)):
+		}
+
+		s.publishState(ws.StateEvent{State: ws.StateConnecting, Attempt: attempt})
+
+		if err := s.open(ctx, ctx); err != nil {
+			if errors.Is(err, ErrSessionClosed) {
+				return err
+			}
+			s.reportError(err)
+			cause = err
+			continue
+		}
+
+		s.publishState(ws.StateEvent{State: ws.StateConnected, Attempt: attempt})
+		return nil
+	}
+}
+
+func (s *Session) shutdown(ctx context.Context, cause error) error {
+	s.closeOnce.Do(func() {
+		s.mu.Lock()
+		s.closed = true
+		user, userCancel, key := s.user, s.userCancel, s.listenKey
+		s.user, s.userCancel, s.listenKey = nil, nil, ""
+		s.mu.Unlock()
+
+		// Ignore the disconnect caused by closing the connection below.
+		s.generation.Add(1)
+
+		if user != nil {
+			userCancel()
+			if err := user.Close(); err != nil {
+				s.closeErr = err
+			}
+		}
+		if key != "" {
+			if _, err := s.closeKeyService(key).Do(ctx); err != nil && s.closeErr == nil {
+				s.closeErr = err
+			}
+		}
+
+		s.publishState(ws.StateEvent{State: ws.StateClosed, Err: cause})
+
+		if cause == nil {
+			cause = ws.ErrClosed
+		}
+		s.life.Finish(cause)
+	})
+	return s.closeErr
+}
+
+// Done returns a channel that is closed once the session has shut down.
+func (s *Session) Done() <-chan struct{} {
+	return s.life.Done()
+}
+
+// Err returns nil while the session is running. Once Done is closed it
+// returns ws.ErrClosed after Close, or the error that ended the session.
+func (s *Session) Err() error {
+	return s.life.Err()
+}
+
+func (s *Session) generateKey(ctx context.Context) (string, error) {
+	svc := keyservice.NewGenerateListenKeyService(s.apiKey, s.secretKey)
+	if s.httpClient != nil {
+		svc.WithClient(s.httpClient)
+	}
+	return svc.Do(ctx)
+}
+
+func (s *Session) keepAlive(ctx context.Context) error {
+	key := s.ListenKey()
+	if key == "" {
+		return nil
+	}
+
+	svc := keyservice.NewKeepAliveListenKeyService(s.apiKey, s.secretKey).ListenKey(key)
+	if s.httpClient != nil {
+		svc.WithClient(s.httpClient)
+	}
+	_, err := svc.Do(ctx)
+	return err
+}
+
+func (s *Session) closeKeyService(key string) *keyservice.CloseListenKeyService {
+	svc := keyservice.NewCloseListenKeyService(s.apiKey, s.secretKey).ListenKey(key)
+	if s.httpClient != nil {
+		svc.WithClient(s.httpClient)
+	}
+	return svc
+}
+
+// closeKey closes a listen key that is no longer used. Failures are only
+// reported since the key expires on its own.
+func (s *Session) closeKey(ctx context.Context, key string) {
+	if _, err := s.closeKeyService(key).Do(ctx); err != nil {
+		s.reportError(err)
+	}
+}
+
+func (s *Session) publishState(e ws.StateEvent) {
+	if s.onStateChange != nil {
+		s.onStateChange(e)
+	}
+}
+
+func (s *Session) reportError(err error) {
+	if s.onError != nil {
+		s.onError(err)
+	}
+}
+
+func (s *Session) errFactory(op string, kind error, cause error) *sdkerr.SDKError {
+	return sdkerr.NewSDKError().
+		WithSubsys(subsys).
+		WithOp(fmt.Sprintf("Session.%s", op)).
+		WithKind(kind).
+		WithCause(cause)
+}
@@ -0,0 +1,344 @@
+package wsuser
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/testutil"
+	"github.com/IvanTurko/mexc-sdk-go/sdkerr"
+	"github.com/IvanTurko/mexc-sdk-go/transport"
+	"github.com/IvanTurko/mexc-sdk-go/ws"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeKeyServer records listen key requests and hands out key-1, key-2, ...
+type fakeKeyServer struct {
+	mu         sync.Mutex
+	generated  int
+	keepAlives []string
+	closed     []string
+	keepAlive  func(key string) error
+}
+
+func (f *fakeKeyServer) client() *testutil.FakeHTTPClient {
+	return &testutil.FakeHTTPClient{
+		DoFunc: func(ctx context.Context, req *transport.Request) (*transport.Response, error) {
+			f.mu.Lock()
+			defer f.mu.Unlock()
+
+			u, err := url.Parse(req.FullURL)
+			if err != nil {
+				return nil, err
+			}
+			key := u.Query().Get("listenKey")
+
+			switch req.Method {
+			case http.MethodPost:
+				f.generated++
+				key = fmt.Sprintf("key-%d", f.generated)
+			case http.MethodPut:
+				f.keepAlives = append(f.keepAlives, key)
+				if f.keepAlive != nil {
+					if err := f.keepAlive(key); err != nil {
+						return &transport.Response{StatusCode: 400, Body: []byte(`{"code":730000,"msg":"listenKey expired"}`)}, nil
+					}
+				}
+			case http.MethodDelete:
+				f.closed = append(f.closed, key)
+			}
+			return &transport.Response{StatusCode: 200, Body: []byte(fmt.Sprintf(`{"listenKey":%q}`, key))}, nil
+		},
+	}
+}
+
+func (f *fakeKeyServer) closedKeys() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.closed...)
+}
+
+// fakeUserStream acknowledges every subscription request on the clients it
+// creates.
+type fakeUserStream struct {
+	mu      sync.Mutex
+	urls    []string
+	clients []*testutil.PipeClient
+
+	// reject is a stream the server refuses to subscribe.
+	reject string
+}
+
+func (f *fakeUserStream) factory(url string) ws.Client {
+	c := testutil.NewPipeClient()
+	c.OnWrite = func(c *testutil.PipeClient, msg []byte) {
+		var req wsRequestPayload
+		if err := json.Unmarshal(msg, &req); err != nil || req.ID == nil || len(req.Params) == 0 {
+			return
+		}
+		if req.Params[0] == f.reject {
+			c.Push([]byte(fmt.Sprintf(`{"id":%d,"code":1,"msg":"rejected"}`, *req.ID)))
+			return
+		}
+		c.Push([]byte(fmt.Sprintf(`{"id":%d,"code":0,"msg":%q}`, *req.ID, req.Params[0])))
+	}
+
+	f.mu.Lock()
+	f.urls = append(f.urls, url)
+	f.clients = append(f.clients, c)
+	f.mu.Unlock()
+	return c
+}
+
+func (f *fakeUserStream) client(i int) *testutil.PipeClient {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if i >= len(f.clients) {
+		return nil
+	}
+	return f.clients[i]
+}
+
+func (f *fakeUserStream) count() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return len(f.clients)
+}
+
+func subscribedStreams(c *testutil.PipeClient) []string {
+	var streams []string
+	for _, msg := range c.Writes() {
+		var req wsRequestPayload
+		if err := json.Unmarshal(msg, &req); err == nil && req.Method == string(subscribe) {
+			streams = append(streams, req.Params[0].(string))
+		}
+	}
+	return streams
+}
+
+func newTestSession(keys *fakeKeyServer, stream *fakeUserStream, opts ...SessionOptions) *Session {
+	opts = append([]SessionOptions{
+		WithSessionHTTPClient(keys.client()),
+		WithSessionFactory(stream.factory),
+		WithSessionBackoff(ws.Backoff{Initial: time.Millisecond, Max: time.Millisecond}),
+	}, opts...)
+	return NewSession("key", "secret", opts...)
+}
+
+func TestSession_Start_SubscribesRegistered(t *testing.T) {
+	keys := &fakeKeyServer{}
+	stream := &fakeUserStream{}
+	s := newTestSession(keys, stream)
+
+	_, err := s.Subscribe(context.Background(), NewSpotAccountOrdersSub(func(*PrivateOrder) {}))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Close(context.Background())
+
+	assert.Equal(t, "key-1", s.ListenKey())
+	assert.Contains(t, stream.urls[0], "listenKey=key-1")
+	assert.Equal(t, []string{"spot@private.orders.v3.api.pb"}, subscribedStreams(stream.client(0)))
+
+	_, err = s.Subscribe(context.Background(), NewSpotAccountDealsSub(func(PrivateDeal) {}))
+	require.NoError(t, err)
+	assert.Len(t, subscribedStreams(stream.client(0)), 2)
+
+	err = s.Start(context.Background())
+	assert.ErrorIs(t, err, ErrSessionStarted)
+}
+
+func TestSession_Start_RejectedSubscriptionInvalidated(t *testing.T) {
+	keys := &fakeKeyServer{}
+	stream := &fakeUserStream{reject: "spot@private.deals.v3.api.pb"}
+	s := newTestSession(keys, stream)
+
+	_, err := s.Subscribe(context.Background(), NewSpotAccountOrdersSub(func(*PrivateOrder) {}))
+	require.NoError(t, err)
+	invalid := make(chan error, 1)
+	deals, err := s.Subscribe(context.Background(), NewSpotAccountDealsSub(func(PrivateDeal) {}).
+		SetOnInvalid(func(err error) { invalid <- err }))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Close(context.Background())
+
+	select {
+	case err := <-invalid:
+		assert.ErrorIs(t, err, sdkerr.ErrWSServerError)
+	case <-time.After(time.Second):
+		t.Fatal("rejected subscription not invalidated")
+	}
+	assert.Equal(t, "key-1", s.ListenKey())
+	assert.Nil(t, s.Err())
+
+	s.mu.Lock()
+	assert.Len(t, s.subs, 1)
+	s.mu.Unlock()
+	assert.NoError(t, deals.Unsubscribe(context.Background()))
+}
+
+func TestSession_Subscribe_Duplicate(t *testing.T) {
+	s := NewSession("key", "secret")
+
+	_, err := s.Subscribe(context.Background(), NewSpotAccountUpdateSub(func(PrivateAccountUpdate) {}))
+	require.NoError(t, err)
+
+	_, err = s.Subscribe(context.Background(), NewSpotAccountUpdateSub(func(PrivateAccountUpdate) {}))
+	assert.ErrorIs(t, err, ErrDuplicateSubscription)
+}
+
+func TestSession_Reconnect_OnDisconnect(t *testing.T) {
+	keys := &fakeKeyServer{}
+	stream := &fakeUserStream{}
+
+	connected := make(chan ws.StateEvent, 10)
+	s := newTestSession(keys, stream, WithSessionOnStateChange(func(e ws.StateEvent) {
+		if e.State == ws.StateConnected && e.Attempt > 0 {
+			connected <- e
+		}
+	}))
+
+	_, err := s.Subscribe(context.Background(), NewSpotAccountOrdersSub(func(*PrivateOrder) {}))
+	require.NoError(t, err)
+	_, err = s.Subscribe(context.Background(), NewSpotAccountDealsSub(func(PrivateDeal) {}))
+	require.NoError(t, err)
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Close(context.Background())
+
+	_ = stream.client(0).Close()
+
+	select {
+	case <-connected:
+	case <-time.After(time.Second):
+		t.Fatal("session did not reconnect")
+	}
+
+	assert.Equal(t, "key-2", s.ListenKey())
+	assert.ElementsMatch(t,
+		[]string{"spot@private.orders.v3.api.pb", "spot@private.deals.v3.api.pb"},
+		subscribedStreams(stream.client(1)),
+	)
+	assert.Eventually(t, func() bool {
+		return assert.ObjectsAreEqual([]string{"key-1"}, keys.closedKeys())
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSession_Reconnect_OnKeepAliveFailure(t *testing.T) {
+	keys := &fakeKeyServer{keepAlive: func(key string) error {
+		if key == "key-1" {
+			return fmt.Errorf("expired")
+		}
+		return nil
+	}}
+	stream := &fakeUserStream{}
+
+	errs := make(chan error, 10)
+	s := newTestSession(keys, stream,
+		WithKeepAliveInterval(10*time.Millisecond),
+		WithSessionOnError(func(err error) { errs <- err }),
+	)
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Close(context.Background())
+
+	select {
+	case err := <-errs:
+		assert.Error(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("keepalive failure was not reported")
+	}
+
+	assert.Eventually(t, func() bool { return s.ListenKey() == "key-2" }, time.Second, 5*time.Millisecond)
+	assert.True(t, stream.client(0).IsClosed())
+}
+
+func TestSession_RotatesKey(t *testing.T) {
+	keys := &fakeKeyServer{}
+	stream := &fakeUserStream{}
+	s := newTestSession(keys, stream, WithKeyRotation(20*time.Millisecond))
+
+	require.NoError(t, s.Start(context.Background()))
+	defer s.Close(context.Background())
+
+	assert.Eventually(t, func() bool { return stream.count() >= 2 && s.ListenKey() != "key-1" }, time.Second, 5*time.Millisecond)
+	assert.Eventually(t, func() bool {
+		closed := keys.closedKeys()
+		return len(closed) > 0 && closed[0] == "key-1"
+	}, time.Second, 5*time.Millisecond)
+}
+
+func TestSession_Close(t *testing.T) {
+	keys := &fakeKeyServer{}
+	stream := &fakeUserStream{}
+
+	var states []ws.ConnState
+	var mu sync.Mutex
+	s := newTestSession(keys, stream, WithSessionOnStateChange(func(e ws.StateEvent) {
+		mu.Lock()
+		states = append(states, e.State)
+		mu.Unlock()
+	}))
+
+	require.NoError(t, s.Start(context.Background()))
+	require.NoError(t, s.Close(context.Background()))
+	require.NoError(t, s.Close(context.Background()))
+
+	assert.True(t, stream.client(0).IsClosed())
+	assert.Equal(t, []string{"key-1"}, keys.closedKeys())
+	assert.Empty(t, s.ListenKey())
+
+	mu.Lock()
+	assert.Equal(t, []ws.ConnState{ws.StateConnecting, ws.StateConnected, ws.StateClosed}, states)
+	mu.Unlock()
+
+	_, err := s.Subscribe(context.Background(), NewSpotAccountOrdersSub(func(*PrivateOrder) {}))
+	assert.ErrorIs(t, err, ErrSessionClosed)
+}
+
+func TestSession_SlowSubscribeDoesNotBlockClose(t *testing.T) {
+	keys := &fakeKeyServer{}
+	s := newTestSession(keys, &fakeUserStream{}, WithSessionFactory(func(string) ws.Client {
+		// Never acknowledges a subscription.
+		return testutil.NewPipeClient()
+	}))
+
+	require.NoError(t, s.Start(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := s.Subscribe(ctx, NewSpotAccountOrdersSub(func(*PrivateOrder) {}))
+		errCh <- err
+	}()
+
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.subs) == 1
+	}, time.Second, time.Millisecond)
+
+	closed := make(chan error, 1)
+	go func() {
+		assert.Equal(t, "key-1", s.ListenKey())
+		closed <- s.Close(context.Background())
+	}()
+
+	select {
+	case err := <-closed:
+		assert.NoError(t, err)
+	case <-time.After(time.Second):
+		t.Fatal("Close blocked by a pending Subscribe")
+	}
+
+	cancel()
+	assert.Error(t, <-errCh)
+}
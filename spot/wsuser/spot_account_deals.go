@@ -37,6 +37,12 @@ func (s *spotAccountDealsSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *spotAccountDealsSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *spotAccountDealsSub) matches(msg *message) (bool, error) {
 	return msg.Msg == s.streamName, nil
 }
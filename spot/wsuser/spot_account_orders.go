@@ -68,6 +68,12 @@ func (s *spotAccountOrdersSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *spotAccountOrdersSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *spotAccountOrdersSub) matches(msg *message) (bool, error) {
 	return msg.Msg == s.streamName, nil
 }
@@ -37,6 +37,12 @@ func (s *spotAccountUpdateSub) SetOnInvalid(f func(error)) Subscription {
 	return s
 }
 
+func (s *spotAccountUpdateSub) reportInvalid(err error) {
+	if s.onInvalid != nil {
+		s.onInvalid(err)
+	}
+}
+
 func (s *spotAccountUpdateSub) matches(msg *message) (bool, error) {
 	return msg.Msg == s.streamName, nil
 }
@@ -13,6 +13,8 @@ type subscriptionSpec interface {
 	wsHandler
 	id() string
 	params() any
+	// reportInvalid passes err to the onInvalid callback, if any.
+	reportInvalid(err error)
 	matches(msg *message) (bool, error)
 }
 
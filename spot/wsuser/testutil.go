@@ -34,6 +34,8 @@ func (m *mockSubscription) SetOnInvalid(f func(error)) Subscription {
 	return m
 }
 
+func (m *mockSubscription) reportInvalid(err error) {}
+
 func (m *mockSubscription) matches(msg *message) (bool, error) {
 	return false, nil
 }
@@ -50,6 +50,7 @@ type SubscriptionHandle interface {
 // WSUser is a WebSocket client for MEXC SPOT user streams.
 type WSUser struct {
 	client         ws.Client
+	clientOpts     []ws.Option
 	waitingTimeout time.Duration
 
 	internalTimeout time.Duration
@@ -57,6 +58,7 @@ type WSUser struct {
 	now             func() time.Time
 	onDisconnect    func(err error)
 	onLatency       func(latency time.Duration)
+	onFramesDropped func(n uint64)
 
 	activeSubs   map[string]SubscriptionHandle
 	activeSubsMu sync.Mutex
@@ -67,6 +69,8 @@ type WSUser struct {
 
 	counter   counter.Counter
 	router    handlerRouter
+	lifetime  context.Context
+	life      wsutil.Lifecycle
 	closeOnce sync.Once
 	closeErr  error
 }
@@ -79,10 +83,7 @@ func NewWSUser(key string, opts ...Options) *WSUser {
 		panic("NewWSUser: listenKey is required")
 	}
 
-	factory := func(url string) ws.Client {
-		return ws.NewClient(url)
-	}
-	return NewWSUserWithFactory(key, factory, opts...)
+	return newWSUser(key, nil, opts...)
 }
 
 // NewWSUserWithFactory is like NewWSUser but uses the provided ws.Client factory.
@@ -96,11 +97,11 @@ func NewWSUserWithFactory(key string, factory func(url string) ws.Client, opts .
 	if factory == nil {
 		panic("NewWSUserWithFactory: factory must not be nil")
 	}
+	return newWSUser(key, factory, opts...)
+}
 
-	url := fmt.Sprintf("%s?listenKey=%s", defaultBaseURL, key)
-
+func newWSUser(key string, factory func(url string) ws.Client, opts ...Options) *WSUser {
 	w := &WSUser{
-		client:         factory(url),
 		waitingTimeout: 1 * time.Second,
 
 		internalTimeout: 1 * time.Second,
@@ -119,9 +120,25 @@ func NewWSUserWithFactory(key string, factory func(url string) ws.Client, opts .
 		opt(w)
 	}
 
+	if factory == nil {
+		factory = func(url string) ws.Client {
+			return ws.NewClient(url, w.clientOpts...)
+		}
+	}
+	w.client = factory(fmt.Sprintf("%s?listenKey=%s", defaultBaseURL, key))
+
 	return w
 }
 
+// WithClientOptions sets the ws.Options (proxy, TLS, headers, limits, ...)
+// used to create the connection. They are ignored when the WSUser was
+// created with NewWSUserWithFactory.
+func WithClientOptions(opts ...ws.Option) Options {
+	return func(w *WSUser) {
+		w.clientOpts = opts
+	}
+}
+
 // WithOnDisconnect registers a callback for unexpected disconnections.
 func WithOnDisconnect(f func(err error)) Options {
 	return func(w *WSUser) {
@@ -136,28 +153,80 @@ func WithPingLatencyHandler(f func(time.Duration)) Options {
 	}
 }
 
+// WithOnFramesDropped registers a callback for frames dropped by the ws.Client
+// backpressure policy (see ws.WithBackpressure). Updates in those frames are
+// lost and account state should be refreshed over REST.
+func WithOnFramesDropped(f func(n uint64)) Options {
+	return func(w *WSUser) {
+		w.onFramesDropped = f
+	}
+}
+
+// WithLifetime binds the connection to ctx: once ctx is done the client is
+// closed. By default it runs until Close is called.
+func WithLifetime(ctx context.Context) Options {
+	return func(w *WSUser) {
+		w.lifetime = ctx
+	}
+}
+
 // Connect opens the WebSocket connection and starts internal workers.
+//
+// ctx only bounds the dial. The connection runs until Close is called, it
+// fails or the WithLifetime context is done; see Done and Err.
 func (w *WSUser) Connect(ctx context.Context) error {
 	err := w.client.Connect(ctx)
 	if err != nil {
 		return w.errFactory("Connect", sdkerr.ErrWSConnection, err)
 	}
-	w.readingMessage(ctx)
-	w.startPinger(ctx)
+
+	lifetime := w.lifetimeContext()
+	w.readingMessage(lifetime)
+	w.startPinger(lifetime)
+	context.AfterFunc(lifetime, func() {
+		_ = w.shutdown(lifetime.Err())
+	})
 	return nil
 }
 
 // Close shuts down the connection and internal workers. Safe to call multiple times.
 func (w *WSUser) Close() error {
+	return w.shutdown(nil)
+}
+
+func (w *WSUser) shutdown(cause error) error {
 	w.closeOnce.Do(func() {
 		err := w.client.Close()
 		if err != nil {
 			w.closeErr = w.errFactory("Close", sdkerr.ErrWSClose, err)
 		}
+
+		if cause == nil {
+			cause = ws.ErrClosed
+		}
+		w.life.Finish(cause)
 	})
 	return w.closeErr
 }
 
+// Done returns a channel that is closed once the client has shut down.
+func (w *WSUser) Done() <-chan struct{} {
+	return w.life.Done()
+}
+
+// Err returns nil while the client is running. Once Done is closed it
+// returns ws.ErrClosed after Close, or the error that ended the client.
+func (w *WSUser) Err() error {
+	return w.life.Err()
+}
+
+func (w *WSUser) lifetimeContext() context.Context {
+	if w.lifetime == nil {
+		return context.Background()
+	}
+	return w.lifetime
+}
+
 // Subscribe registers a new subscription and waits for server acknowledgment.
 //
 // Returns a SubscriptionHandle used for safe unsubscription.
@@ -255,6 +324,8 @@ func (w *WSUser) startPinger(ctx context.Context) {
 			select {
 			case <-ctx.Done():
 				return
+			case <-w.life.Done():
+				return
 			case <-ticker.C:
 				if err := w.sendPing(); err != nil {
 					_ = w.Close()
@@ -353,18 +424,22 @@ func (w *WSUser) sendAndAwaitResponse(
 
 func (w *WSUser) readingMessage(ctx context.Context) {
 	go func() {
-		defer w.Close()
 		for {
 			select {
 			case <-ctx.Done():
+				_ = w.shutdown(ctx.Err())
 				return
 			default:
 				data, err := w.client.ReadMessage()
+				if err != nil && w.handleDrop(err) {
+					continue
+				}
 				if err != nil {
 					err = w.errFactory("readingMessage", sdkerr.ErrWSRead, err)
 					if w.onDisconnect != nil {
 						w.onDisconnect(err)
 					}
+					_ = w.shutdown(err)
 					return
 				}
 				w.handleMessage(data)
@@ -427,3 +502,25 @@ func ensureDeadline(ctx context.Context, fallback time.Duration) (context.Contex
 	}
 	return context.WithTimeout(ctx, fallback)
 }
+
+// Stats returns the buffer statistics of the current connection. It is the
+// zero value if the ws.Client does not implement ws.StatsReporter.
+func (w *WSUser) Stats() ws.Stats {
+	if r, ok := w.client.(ws.StatsReporter); ok {
+		return r.Stats()
+	}
+	return ws.Stats{}
+}
+
+// handleDrop reports whether err signals dropped frames and handles it.
+func (w *WSUser) handleDrop(err error) bool {
+	var dropErr *ws.DropError
+	if !errors.As(err, &dropErr) {
+		return false
+	}
+
+	if w.onFramesDropped != nil {
+		w.onFramesDropped(dropErr.Count)
+	}
+	return true
+}
@@ -0,0 +1,176 @@
+package ws
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+)
+
+// BackpressurePolicy decides what happens to a received frame when the
+// output buffer is full because the consumer reads too slowly.
+type BackpressurePolicy int
+
+const (
+	// BackpressureDropNewest discards the frame that does not fit.
+	BackpressureDropNewest BackpressurePolicy = iota
+	// BackpressureDropOldest discards the oldest buffered frame to make room.
+	BackpressureDropOldest
+	// BackpressureBlock stops reading from the connection until there is room.
+	BackpressureBlock
+	// BackpressureFail closes the connection with ErrBackpressure.
+	BackpressureFail
+)
+
+func (p BackpressurePolicy) String() string {
+	switch p {
+	case BackpressureDropNewest:
+		return "DROP_NEWEST"
+	case BackpressureDropOldest:
+		return "DROP_OLDEST"
+	case BackpressureBlock:
+		return "BLOCK"
+	case BackpressureFail:
+		return "FAIL"
+	default:
+		return fmt.Sprintf("BackpressurePolicy(%d)", int(p))
+	}
+}
+
+var (
+	// ErrFramesDropped is matched by the *DropError returned from ReadMessage
+	// after frames were discarded.
+	ErrFramesDropped = errors.New("frames dropped")
+	// ErrBackpressure ends the connection under BackpressureFail.
+	ErrBackpressure = errors.New("output buffer full")
+)
+
+// DropError is returned by ReadMessage in place of frames dropped under
+// BackpressureDropNewest or BackpressureDropOldest, in stream order. The
+// connection keeps running; stateful streams should resync.
+type DropError struct {
+	Count uint64
+}
+
+func (e *DropError) Error() string {
+	return fmt.Sprintf("%v: %d", ErrFramesDropped, e.Count)
+}
+
+func (e *DropError) Unwrap() error {
+	return ErrFramesDropped
+}
+
+// Stats is a snapshot of the output buffer counters.
+type Stats struct {
+	// Received is the number of frames read from the connection.
+	Received uint64
+	// Dropped is the number of frames discarded because the buffer was full.
+	Dropped uint64
+	// HighWater is the largest number of frames buffered at once.
+	HighWater int
+	// Capacity is the size of the buffer.
+	Capacity int
+}
+
+// StatsReporter is implemented by clients that expose buffer statistics,
+// such as the one returned by NewClient.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+type bufferStats struct {
+	received  atomic.Uint64
+	dropped   atomic.Uint64
+	highWater atomic.Int64
+}
+
+func (s *bufferStats) observe(n int) {
+	for {
+		cur := s.highWater.Load()
+		if int64(n) <= cur || s.highWater.CompareAndSwap(cur, int64(n)) {
+			return
+		}
+	}
+}
+
+// WithBackpressure sets the policy applied when the output buffer is full.
+// The default is BackpressureDropNewest.
+func WithBackpressure(p BackpressurePolicy) Option {
+	return func(c *clientImp) {
+		c.policy = p
+	}
+}
+
+// Stats returns a snapshot of the output buffer counters.
+func (c *clientImp) Stats() Stats {
+	return Stats{
+		Received:  c.stats.received.Load(),
+		Dropped:   c.stats.dropped.Load(),
+		HighWater: int(c.stats.highWater.Load()),
+		Capacity:  cap(c.outCh),
+	}
+}
+
+// enqueue hands res to ReadMessage according to the backpressure policy.
+// It returns false if the reader has to stop.
+func (c *clientImp) enqueue(res msgResult) bool {
+	if res.err == nil {
+		res.seq = c.stats.received.Add(1)
+	}
+
+	select {
+	case c.outCh <- res:
+		c.stats.observe(len(c.outCh))
+		return true
+	default:
+	}
+
+	// An error ends the stream. If it does not fit, ReadMessage reports it
+	// through Err once the buffer is drained.
+	if res.err != nil {
+		return false
+	}
+
+	switch c.policy {
+	case BackpressureBlock:
+		return c.blockingSend(res)
+
+	case BackpressureDropOldest:
+		for {
+			select {
+			case <-c.outCh:
+				c.stats.dropped.Add(1)
+			default:
+			}
+
+			select {
+			case c.outCh <- res:
+				c.stats.observe(len(c.outCh))
+				c.errorf("WS buffer full — dropped oldest frame")
+				return true
+			default:
+			}
+		}
+
+	case BackpressureFail:
+		c.errorf("WS buffer full — closing connection")
+		_ = c.closeWith(ErrBackpressure)
+		return false
+
+	default:
+		c.stats.dropped.Add(1)
+		c.errorf("WS message dropped — increase buffer or read faster")
+		return true
+	}
+}
+
+func (c *clientImp) blockingSend(res msgResult) bool {
+	select {
+	case c.outCh <- res:
+		c.stats.observe(len(c.outCh))
+		return true
+	case <-c.life.Done():
+		return false
+	}
+}
+
+var _ StatsReporter = (*clientImp)(nil)
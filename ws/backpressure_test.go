@@ -0,0 +1,119 @@
+package ws
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fillClient starts a reader on a client with a buffer of size 2 and feeds it
+// frames "1".."n".
+func fillClient(t *testing.T, policy BackpressurePolicy, n int) *clientImp {
+	t.Helper()
+
+	conn := newFakeConn()
+	c := &clientImp{
+		conn:   conn,
+		outCh:  make(chan msgResult, 2),
+		policy: policy,
+	}
+	for i := 1; i <= n; i++ {
+		conn.readCh <- readResp{msgType: websocket.TextMessage, data: []byte{byte('0' + i)}}
+	}
+
+	go c.startReader(context.Background())
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+func readAll(t *testing.T, c *clientImp, n int) []string {
+	t.Helper()
+
+	var out []string
+	for range n {
+		msg, err := c.ReadMessage()
+		if err != nil {
+			out = append(out, err.Error())
+			continue
+		}
+		out = append(out, string(msg))
+	}
+	return out
+}
+
+func TestBackpressure_DropNewest(t *testing.T) {
+	c := fillClient(t, BackpressureDropNewest, 4)
+
+	require.Eventually(t, func() bool { return c.Stats().Dropped == 2 }, time.Second, time.Millisecond)
+
+	assert.Equal(t, []string{"1", "2"}, readAll(t, c, 2))
+
+	_, err := c.ReadMessage()
+	var dropErr *DropError
+	require.ErrorAs(t, err, &dropErr)
+	assert.ErrorIs(t, err, ErrFramesDropped)
+	assert.Equal(t, uint64(2), dropErr.Count)
+
+	assert.Equal(t, Stats{Received: 4, Dropped: 2, HighWater: 2, Capacity: 2}, c.Stats())
+}
+
+func TestBackpressure_DropOldest(t *testing.T) {
+	c := fillClient(t, BackpressureDropOldest, 4)
+
+	require.Eventually(t, func() bool { return c.Stats().Dropped == 2 }, time.Second, time.Millisecond)
+
+	_, err := c.ReadMessage()
+	assert.ErrorIs(t, err, ErrFramesDropped)
+	assert.Equal(t, []string{"3", "4"}, readAll(t, c, 2))
+	assert.Equal(t, uint64(2), c.Stats().Dropped)
+}
+
+func TestBackpressure_DropInStreamOrder(t *testing.T) {
+	conn := newFakeConn()
+	c := &clientImp{conn: conn, outCh: make(chan msgResult, 2)}
+	send := func(b byte) {
+		conn.readCh <- readResp{msgType: websocket.TextMessage, data: []byte{b}}
+	}
+	for _, b := range []byte("123") {
+		send(b)
+	}
+	go c.startReader(context.Background())
+	t.Cleanup(func() { _ = c.Close() })
+
+	require.Eventually(t, func() bool { return c.Stats().Dropped == 1 }, time.Second, time.Millisecond)
+	assert.Equal(t, []string{"1"}, readAll(t, c, 1))
+
+	send('4')
+	require.Eventually(t, func() bool { return c.Stats().Received == 4 }, time.Second, time.Millisecond)
+
+	// Frame 2 was queued before 3 was dropped, frame 4 arrived after.
+	assert.Equal(t, []string{"2", (&DropError{Count: 1}).Error(), "4"}, readAll(t, c, 3))
+}
+
+func TestBackpressure_Block(t *testing.T) {
+	c := fillClient(t, BackpressureBlock, 4)
+
+	require.Eventually(t, func() bool { return c.Stats().Received == 3 }, time.Second, time.Millisecond)
+
+	assert.Equal(t, []string{"1", "2", "3", "4"}, readAll(t, c, 4))
+	assert.Equal(t, uint64(0), c.Stats().Dropped)
+}
+
+func TestBackpressure_Fail(t *testing.T) {
+	c := fillClient(t, BackpressureFail, 4)
+
+	select {
+	case <-c.Done():
+	case <-time.After(time.Second):
+		t.Fatal("connection not closed")
+	}
+	assert.ErrorIs(t, c.Err(), ErrBackpressure)
+
+	assert.Equal(t, []string{"1", "2"}, readAll(t, c, 2))
+	_, err := c.ReadMessage()
+	assert.ErrorIs(t, err, ErrBackpressure)
+}
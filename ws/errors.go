@@ -11,6 +11,8 @@ import (
 )
 
 var (
+	// ErrClosed is reported by Err after the client was closed with Close.
+	ErrClosed = errors.New("connection closed")
 	// ErrWriteTimeout is returned when a write operation times out.
 	ErrWriteTimeout = errors.New("write timeout")
 	// ErrWriteFailed is returned when a write operation fails.
@@ -12,30 +12,106 @@ import (
 type msgResult struct {
 	msg []byte
 	err error
+	seq uint64 // position among received frames, 0 for errors
 }
 
-// Connect establishes a websocket connection.
+// Connect establishes a websocket connection. ctx bounds the dial only.
 func (c *clientImp) Connect(ctx context.Context) error {
-	conn, _, err := websocket.DefaultDialer.DialContext(ctx, c.url, nil)
+	dialer := c.dialer
+	if dialer == nil {
+		dialer = websocket.DefaultDialer
+	}
+
+	conn, _, err := dialer.DialContext(ctx, c.url, c.header)
 	if err != nil {
 		c.errorf("connect failed: %v", err)
 		return classifyWSError(err)
 	}
+	if c.readLimit > 0 {
+		conn.SetReadLimit(c.readLimit)
+	}
 
 	c.conn = conn
 	c.debugf("connected to %s", c.url)
 
-	go c.startReader(ctx)
+	lifetime := c.lifetime
+	if lifetime == nil {
+		lifetime = context.Background()
+	}
+	go c.startReader(lifetime)
 	return nil
 }
 
 // ReadMessage reads a message from the websocket connection.
+//
+// After frames were dropped by the backpressure policy it returns a
+// *DropError at the position of the gap: frames returned before it were
+// received before the dropped ones, frames returned after it were received
+// later. Reading can continue afterwards. Once the connection has ended and
+// the buffer is drained it returns Err.
 func (c *clientImp) ReadMessage() ([]byte, error) {
-	res := <-c.outCh
+	c.readMu.Lock()
+	defer c.readMu.Unlock()
+
+	if res := c.stash; res != nil {
+		c.stash = nil
+		return res.msg, res.err
+	}
+
+	var res msgResult
+	select {
+	case res = <-c.outCh:
+	default:
+		// Nothing is buffered, so drops not reported yet are the newest
+		// frames.
+		if err := c.trailingDrops(); err != nil {
+			return nil, err
+		}
+		select {
+		case res = <-c.outCh:
+		case <-c.life.Done():
+			select {
+			case res = <-c.outCh:
+			default:
+				if err := c.trailingDrops(); err != nil {
+					return nil, err
+				}
+				return nil, c.life.Err()
+			}
+		}
+	}
+
+	if res.err == nil {
+		gap := res.seq - c.lastSeq - 1
+		c.lastSeq = res.seq
+		if gap > 0 {
+			c.reported += gap
+			c.stash = &res
+			return nil, &DropError{Count: gap}
+		}
+	}
 	return res.msg, res.err
 }
 
+// trailingDrops reports frames dropped after the last one returned. It must
+// only be called while the buffer is empty.
+func (c *clientImp) trailingDrops() error {
+	dropped := c.stats.dropped.Load()
+	if dropped <= c.reported {
+		return nil
+	}
+	n := dropped - c.reported
+	c.reported = dropped
+	c.lastSeq += n
+	return &DropError{Count: n}
+}
+
 func (c *clientImp) startReader(ctx context.Context) {
+	// Closing the connection unblocks a pending read.
+	stop := context.AfterFunc(ctx, func() {
+		_ = c.closeWith(ctx.Err())
+	})
+	defer stop()
 	defer c.Close()
 	c.debugf("reader started")
 
@@ -43,6 +119,7 @@ func (c *clientImp) startReader(ctx context.Context) {
 		select {
 		case <-ctx.Done():
 			c.debugf("reader stopped by ctx")
+			_ = c.closeWith(ctx.Err())
 			return
 		default:
 			if !c.readAndHandle() {
@@ -61,20 +138,12 @@ func (c *clientImp) readAndHandle() bool {
 	if err != nil {
 		c.errorf("recv [%s] error: %v", msgTypeStr, err)
 		err = classifyWSError(err)
-		c.sendOrDrop(buf, err)
+		c.enqueue(msgResult{msg: buf, err: err})
+		_ = c.closeWith(err)
 		return false
 	}
 
-	c.sendOrDrop(buf, nil)
-	return true
-}
-
-func (c *clientImp) sendOrDrop(buf []byte, err error) {
-	select {
-	case c.outCh <- msgResult{msg: buf, err: err}:
-	default:
-		c.errorf("WS message dropped — increase buffer or read faster")
-	}
+	return c.enqueue(msgResult{msg: buf})
 }
 
 func logWSMessage(c *clientImp, msgTypeStr string, buf []byte) {
@@ -109,6 +178,22 @@ func (c *clientImp) WriteMessage(data []byte) error {
 
 // Close closes the websocket connection.
 func (c *clientImp) Close() error {
+	return c.closeWith(ErrClosed)
+}
+
+// Done returns a channel that is closed once the connection has ended.
+func (c *clientImp) Done() <-chan struct{} {
+	return c.life.Done()
+}
+
+// Err returns the reason the connection ended, or nil while it is running.
+func (c *clientImp) Err() error {
+	return c.life.Err()
+}
+
+var _ Lifecycle = (*clientImp)(nil)
+
+func (c *clientImp) closeWith(cause error) error {
 	c.closeOnce.Do(func() {
 		if nil == c.conn {
 			panic("Close: cannot call Close before successful Connect")
@@ -119,6 +204,7 @@ func (c *clientImp) Close() error {
 			c.errorf("connection close failed: %v", err)
 			c.closeErr = classifyWSError(err)
 		}
+		c.life.Finish(cause)
 	})
 	return c.closeErr
 }
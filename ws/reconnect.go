@@ -0,0 +1,89 @@
+package ws
+
+import (
+	"math/rand/v2"
+	"time"
+)
+
+// ConnState describes the lifecycle state of a stream client.
+type ConnState string
+
+const (
+	StateConnecting   ConnState = "CONNECTING"
+	StateConnected    ConnState = "CONNECTED"
+	StateReconnecting ConnState = "RECONNECTING"
+	StateClosed       ConnState = "CLOSED"
+	// StateResynced follows StateConnected after a reconnect once the
+	// stream is fully restored. Events sent during the gap may have been
+	// missed.
+	StateResynced ConnState = "RESYNCED"
+)
+
+// StateEvent is published when a stream client changes state.
+type StateEvent struct {
+	State ConnState
+	// Attempt is the reconnect attempt number, starting at 1.
+	// It is zero outside of reconnects.
+	Attempt int
+	// Err is the error that caused the state change, if any.
+	Err error
+}
+
+// Backoff configures the delays between reconnect attempts.
+//
+// The delay before attempt n is Initial * Multiplier^(n-1), capped at Max,
+// with up to Jitter (a fraction between 0 and 1) of it randomised away.
+type Backoff struct {
+	Initial    time.Duration
+	Max        time.Duration
+	Multiplier float64
+	Jitter     float64
+	// MaxAttempts stops reconnecting after that many failed attempts.
+	// Zero retries forever.
+	MaxAttempts int
+}
+
+// DefaultBackoff returns a Backoff starting at 500ms and capped at 30s,
+// doubling with 20% jitter and retrying forever.
+func DefaultBackoff() Backoff {
+	return Backoff{
+		Initial:    500 * time.Millisecond,
+		Max:        30 * time.Second,
+		Multiplier: 2,
+		Jitter:     0.2,
+	}
+}
+
+// Delay returns the delay before the given attempt, starting at 1.
+func (b Backoff) Delay(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+
+	mult := b.Multiplier
+	if mult < 1 {
+		mult = 1
+	}
+
+	d := float64(b.Initial)
+	for i := 1; i < attempt; i++ {
+		d *= mult
+		if b.Max > 0 && d >= float64(b.Max) {
+			break
+		}
+	}
+	if b.Max > 0 && d > float64(b.Max) {
+		d = float64(b.Max)
+	}
+
+	if b.Jitter > 0 {
+		jitter := min(b.Jitter, 1)
+		d -= d * jitter * rand.Float64()
+	}
+	return time.Duration(d)
+}
+
+// Exhausted reports whether attempt exceeds MaxAttempts.
+func (b Backoff) Exhausted(attempt int) bool {
+	return b.MaxAttempts > 0 && attempt > b.MaxAttempts
+}
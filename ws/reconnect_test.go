@@ -0,0 +1,37 @@
+package ws
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBackoff_Delay(t *testing.T) {
+	b := Backoff{Initial: 100 * time.Millisecond, Max: time.Second, Multiplier: 2}
+
+	assert.Equal(t, 100*time.Millisecond, b.Delay(0))
+	assert.Equal(t, 100*time.Millisecond, b.Delay(1))
+	assert.Equal(t, 200*time.Millisecond, b.Delay(2))
+	assert.Equal(t, 800*time.Millisecond, b.Delay(4))
+	assert.Equal(t, time.Second, b.Delay(5))
+	assert.Equal(t, time.Second, b.Delay(100))
+}
+
+func TestBackoff_DelayJitter(t *testing.T) {
+	b := Backoff{Initial: time.Second, Multiplier: 1, Jitter: 0.5}
+
+	for range 100 {
+		d := b.Delay(3)
+		assert.GreaterOrEqual(t, d, 500*time.Millisecond)
+		assert.LessOrEqual(t, d, time.Second)
+	}
+}
+
+func TestBackoff_Exhausted(t *testing.T) {
+	assert.False(t, Backoff{}.Exhausted(1000))
+
+	b := Backoff{MaxAttempts: 3}
+	assert.False(t, b.Exhausted(3))
+	assert.True(t, b.Exhausted(4))
+}
@@ -2,11 +2,22 @@ package ws
 
 import (
 	"context"
+	"crypto/tls"
+	"net"
+	"net/http"
+	"net/url"
 	"sync"
 	"time"
+
+	"github.com/IvanTurko/mexc-sdk-go/internal/wsutil"
+	"github.com/gorilla/websocket"
 )
 
 // Client is the interface for a websocket client.
+//
+// The context passed to Connect only bounds the dial. The connection then
+// runs until Close is called, the connection fails or the lifetime context
+// (see WithLifetime) is done.
 type Client interface {
 	Connect(context.Context) error
 	ReadMessage() ([]byte, error)
@@ -14,6 +25,17 @@ type Client interface {
 	Close() error
 }
 
+// Lifecycle is implemented by clients that report when the connection has
+// ended, such as the one returned by NewClient.
+type Lifecycle interface {
+	// Done returns a channel that is closed once the connection has ended.
+	Done() <-chan struct{}
+	// Err returns nil while the connection is running. Once Done is closed
+	// it returns ErrClosed after Close, the context error if the lifetime
+	// context ended it, or the error that broke the connection.
+	Err() error
+}
+
 // Conn is an interface for a websocket connection.
 type Conn interface {
 	Close() error
@@ -35,11 +57,25 @@ type clientImp struct {
 	conn   Conn
 	logger Logger
 
+	dialer    *websocket.Dialer
+	header    http.Header
+	readLimit int64
+
 	url              string
 	mu               sync.Mutex
 	outCh            chan msgResult
 	userWriteTimeout time.Duration
+	lifetime         context.Context
+	policy           BackpressurePolicy
+	stats            bufferStats
 
+	// Consumer side of outCh, used by ReadMessage to place drops in order.
+	readMu   sync.Mutex
+	lastSeq  uint64
+	reported uint64
+	stash    *msgResult
+
+	life      wsutil.Lifecycle
 	closeOnce sync.Once
 	closeErr  error
 }
@@ -47,8 +83,11 @@ type clientImp struct {
 // NewClient creates a new websocket client for the given URL.
 //
 // By default:
-//   - The client uses a buffered output channel of size 1000.
+//   - The client uses a buffered output channel of size 1000. Frames that
+//     do not fit are dropped; see WithBackpressure.
 //   - The write timeout is 300 milliseconds.
+//   - Connections are dialed like websocket.DefaultDialer: proxy taken from
+//     the environment and a 45 second handshake timeout.
 //
 // Options can be passed to override defaults (e.g., custom logger, write timeout, or channel size).
 //
@@ -58,9 +97,13 @@ func NewClient(url string, opts ...Option) Client {
 		panic("url must not be empty")
 	}
 
+	dialer := *websocket.DefaultDialer
+
 	c := &clientImp{
+		dialer:           &dialer,
 		url:              url,
 		userWriteTimeout: 300 * time.Millisecond,
+		lifetime:         context.Background(),
 	}
 
 	for _, opt := range opts {
@@ -98,3 +141,69 @@ func WithBufferedOutCh(n int) Option {
 		c.outCh = make(chan msgResult, n)
 	}
 }
+
+// WithLifetime binds the connection to ctx: once ctx is done the connection
+// is closed. By default it runs until Close is called.
+func WithLifetime(ctx context.Context) Option {
+	return func(c *clientImp) {
+		if ctx != nil {
+			c.lifetime = ctx
+		}
+	}
+}
+
+// WithProxy sets the proxy used to dial the connection, in the form of
+// http.Transport.Proxy. Use http.ProxyURL for a fixed HTTP(S) or socks5://
+// proxy. The default is http.ProxyFromEnvironment; nil disables proxies.
+func WithProxy(proxy func(*http.Request) (*url.URL, error)) Option {
+	return func(c *clientImp) {
+		c.dialer.Proxy = proxy
+	}
+}
+
+// WithTLSConfig sets the TLS configuration used for wss:// connections.
+func WithTLSConfig(cfg *tls.Config) Option {
+	return func(c *clientImp) {
+		c.dialer.TLSClientConfig = cfg
+	}
+}
+
+// WithHeader sets HTTP headers sent with the handshake request.
+func WithHeader(h http.Header) Option {
+	return func(c *clientImp) {
+		c.header = h
+	}
+}
+
+// WithLocalAddr binds outgoing connections to the given local address.
+// It replaces a dial function set with WithNetDialContext.
+func WithLocalAddr(addr net.Addr) Option {
+	return func(c *clientImp) {
+		d := &net.Dialer{LocalAddr: addr}
+		c.dialer.NetDialContext = d.DialContext
+	}
+}
+
+// WithNetDialContext sets the function used to open TCP connections, to the
+// proxy if one is configured. It replaces WithLocalAddr.
+func WithNetDialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) Option {
+	return func(c *clientImp) {
+		c.dialer.NetDialContext = dial
+	}
+}
+
+// WithHandshakeTimeout sets the timeout of the websocket handshake.
+// The default is 45 seconds; zero means no timeout besides the dial context.
+func WithHandshakeTimeout(d time.Duration) Option {
+	return func(c *clientImp) {
+		c.dialer.HandshakeTimeout = d
+	}
+}
+
+// WithReadLimit sets the maximum size in bytes of a received message.
+// A larger message ends the connection. Zero, the default, means no limit.
+func WithReadLimit(n int64) Option {
+	return func(c *clientImp) {
+		c.readLimit = n
+	}
+}
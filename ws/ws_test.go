@@ -2,12 +2,20 @@ package ws
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 var errFake error = errors.New("fake error")
@@ -217,3 +225,118 @@ func (c *fakeConn) Close() error {
 }
 
 var _ Conn = (*fakeConn)(nil)
+
+func Test_Lifecycle(t *testing.T) {
+	t.Run("close", func(t *testing.T) {
+		t.Parallel()
+
+		c := newFakeClient()
+		assert.NoError(t, c.Err())
+
+		_ = c.Close()
+
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("done not closed")
+		}
+		assert.ErrorIs(t, c.Err(), ErrClosed)
+	})
+
+	t.Run("read error", func(t *testing.T) {
+		t.Parallel()
+
+		c := newFakeClient()
+		c.conn.(*fakeConn).readCh <- readResp{err: errFake}
+
+		go c.startReader(context.Background())
+
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("done not closed")
+		}
+		assert.ErrorIs(t, c.Err(), ErrWSInternalError)
+		assert.True(t, c.conn.(*fakeConn).closed)
+	})
+
+	t.Run("lifetime ends", func(t *testing.T) {
+		t.Parallel()
+
+		c := newFakeClient()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		go c.startReader(ctx)
+		cancel()
+
+		select {
+		case <-c.Done():
+		case <-time.After(time.Second):
+			t.Fatal("done not closed")
+		}
+		assert.ErrorIs(t, c.Err(), context.Canceled)
+	})
+}
+
+func Test_DialOptions(t *testing.T) {
+	upgrader := websocket.Upgrader{}
+	headers := make(chan http.Header, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Clone()
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		_ = conn.WriteMessage(websocket.TextMessage, []byte(strings.Repeat("x", 64)))
+		_, _, _ = conn.ReadMessage()
+	}))
+	defer srv.Close()
+
+	var dialed atomic.Bool
+	netDial := func(ctx context.Context, network, addr string) (net.Conn, error) {
+		dialed.Store(true)
+		var d net.Dialer
+		return d.DialContext(ctx, network, addr)
+	}
+
+	c := NewClient("ws"+strings.TrimPrefix(srv.URL, "http"),
+		WithHeader(http.Header{"X-Test": []string{"1"}}),
+		WithProxy(nil),
+		WithNetDialContext(netDial),
+		WithHandshakeTimeout(time.Second),
+		WithReadLimit(16),
+	)
+
+	require.NoError(t, c.Connect(context.Background()))
+	defer c.Close()
+
+	assert.True(t, dialed.Load())
+	assert.Equal(t, "1", (<-headers).Get("X-Test"))
+
+	_, err := c.ReadMessage()
+	assert.ErrorIs(t, err, ErrWSPayloadCorrupted)
+}
+
+func Test_DialOptions_Dialer(t *testing.T) {
+	proxyURL, _ := url.Parse("socks5://127.0.0.1:1080")
+	cfg := &tls.Config{ServerName: "example.com"}
+
+	c := NewClient("wss://example.com",
+		WithProxy(http.ProxyURL(proxyURL)),
+		WithTLSConfig(cfg),
+		WithLocalAddr(&net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)}),
+		WithHandshakeTimeout(3*time.Second),
+	).(*clientImp)
+
+	got, err := c.dialer.Proxy(nil)
+	require.NoError(t, err)
+	assert.Equal(t, proxyURL, got)
+	assert.Same(t, cfg, c.dialer.TLSClientConfig)
+	assert.NotNil(t, c.dialer.NetDialContext)
+	assert.Equal(t, 3*time.Second, c.dialer.HandshakeTimeout)
+
+	// Options must not leak into the shared default dialer.
+	assert.Nil(t, websocket.DefaultDialer.TLSClientConfig)
+}